@@ -123,6 +123,7 @@ import (
 	"github.com/IBM/logs-go-sdk/logsv0"
 	scc "github.com/IBM/scc-go-sdk/v5/securityandcompliancecenterapiv3"
 	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	httptransport "github.com/go-openapi/runtime/client"
 )
 
 // RetryAPIDelay - retry api delay
@@ -196,6 +197,21 @@ type Config struct {
 	Zone          string
 	Visibility    string
 	EndpointsFile string
+
+	// Maximum number of Power Systems Virtual Server reads running at the same time, 0 is unlimited
+	PIReadConcurrency int
+
+	// Power Systems Virtual Server workspace used when pi_cloud_instance_id is omitted
+	PIDefaultWorkspaceID string
+
+	// File the durations of the Power Systems resource operations are written to, empty to disable
+	PIMetricsFile string
+
+	// Number of times a Power Systems Virtual Server request that failed with a retriable status is
+	// sent again, 0 to disable, the initial backoff between them, and the retriable status codes
+	PIMaxRetries       int
+	PIRetryBackoff     time.Duration
+	PIRetryStatusCodes []int
 }
 
 // Session stores the information required for communication with the SoftLayer and Bluemix API
@@ -214,6 +230,9 @@ type ClientSession interface {
 	BluemixAcccountAPI() (accountv2.AccountServiceAPI, error)
 	BluemixAcccountv1API() (accountv1.AccountServiceAPI, error)
 	BluemixUserDetails() (*UserConfig, error)
+	IBMPIReadLimiter() chan struct{}
+	IBMPIDefaultWorkspaceID() string
+	IBMPIMetrics() *IBMPIMetrics
 	ContainerAPI() (containerv1.ContainerServiceAPI, error)
 	VpcContainerAPI() (containerv2.ContainerServiceAPI, error)
 	ContainerRegistryV1() (*containerregistryv1.ContainerRegistryV1, error)
@@ -379,8 +398,11 @@ type clientSession struct {
 	resourceCatalogConfigErr  error
 	resourceCatalogServiceAPI catalog.ResourceCatalogAPI
 
-	ibmpiConfigErr error
-	ibmpiSession   *ibmpisession.IBMPISession
+	ibmpiConfigErr          error
+	ibmpiSession            *ibmpisession.IBMPISession
+	ibmpiReadLimiter        chan struct{}
+	ibmpiDefaultWorkspaceID string
+	ibmpiMetrics            *IBMPIMetrics
 
 	kpErr error
 	kpAPI *kp.API
@@ -878,6 +900,21 @@ func (sess clientSession) IBMPISession() (*ibmpisession.IBMPISession, error) {
 	return sess.ibmpiSession, sess.ibmpiConfigErr
 }
 
+// IBMPIReadLimiter returns the semaphore bounding concurrent Power Systems reads, nil when unlimited
+func (sess clientSession) IBMPIReadLimiter() chan struct{} {
+	return sess.ibmpiReadLimiter
+}
+
+// IBMPIDefaultWorkspaceID returns the Power Systems workspace used when pi_cloud_instance_id is omitted
+func (sess clientSession) IBMPIDefaultWorkspaceID() string {
+	return sess.ibmpiDefaultWorkspaceID
+}
+
+// IBMPIMetrics returns the durations of the Power Systems resource operations, nil when disabled
+func (sess clientSession) IBMPIMetrics() *IBMPIMetrics {
+	return sess.ibmpiMetrics
+}
+
 // Private DNS Service
 
 func (sess clientSession) PrivateDNSClientSession() (*dns.DnsSvcsV1, error) {
@@ -2188,7 +2225,14 @@ func (c *Config) ClientSession() (interface{}, error) {
 	session.apigatewayAPI = apigatewayAPI
 
 	// POWER SYSTEMS Service
-	piURL := ContructEndpoint(c.Region, "power-iaas.cloud.ibm.com")
+	piURL := ContructEndpoint(c.Region, fmt.Sprintf("power-iaas.%s", cloudEndpoint))
+	if c.Visibility == "private" {
+		piURL = ContructEndpoint(fmt.Sprintf("private.%s", c.Region), fmt.Sprintf("power-iaas.%s", cloudEndpoint))
+	}
+	if fileMap != nil && c.Visibility != "public-and-private" {
+		// a zone specific endpoint takes precedence over the regional one
+		piURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PI_API_ENDPOINT", c.Zone, fileFallBack(fileMap, c.Visibility, "IBMCLOUD_PI_API_ENDPOINT", c.Region, piURL))
+	}
 	ibmPIOptions := &ibmpisession.IBMPIOptions{
 		Authenticator: authenticator,
 		Debug:         os.Getenv("TF_LOG") != "",
@@ -2200,8 +2244,16 @@ func (c *Config) ClientSession() (interface{}, error) {
 	ibmpisession, err := ibmpisession.NewIBMPISession(ibmPIOptions)
 	if err != nil {
 		session.ibmpiConfigErr = fmt.Errorf("Error occured while configuring ibmpisession: %q", err)
+	} else if rt, ok := ibmpisession.Power.Transport.(*httptransport.Runtime); ok {
+		rt.Transport = newIBMPIRetryTransport(ibmpiMaintenanceRoundTripper{next: rt.Transport}, c.PIMaxRetries, c.PIRetryBackoff, c.PIRetryStatusCodes)
+		ibmpisession.Power.SetTransport(newIBMPIMaintenanceTransport(rt))
 	}
 	session.ibmpiSession = ibmpisession
+	if c.PIReadConcurrency > 0 {
+		session.ibmpiReadLimiter = make(chan struct{}, c.PIReadConcurrency)
+	}
+	session.ibmpiDefaultWorkspaceID = c.PIDefaultWorkspaceID
+	session.ibmpiMetrics = NewIBMPIMetrics(c.PIMetricsFile)
 
 	// PRIVATE DNS Service
 	pdnsURL := dns.DefaultServiceURL
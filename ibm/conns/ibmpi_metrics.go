@@ -0,0 +1,132 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/json"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// IBMPIMetrics records how long the create, update and delete operations of the Power Systems
+// resources take, including the waits for the objects to become ready, when the pi_metrics_file
+// provider setting is set. Each operation is logged, and the summary of the run so far is written
+// to the file after each operation, so it holds the summary of the whole apply once it ends.
+type IBMPIMetrics struct {
+	path string
+
+	mu         sync.Mutex
+	operations map[ibmpiOperation]*IBMPIOperationStats
+}
+
+type ibmpiOperation struct {
+	resourceType string
+	operation    string
+}
+
+// IBMPIOperationStats is the summary of one operation of one resource type.
+type IBMPIOperationStats struct {
+	ResourceType   string  `json:"resource_type"`
+	Operation      string  `json:"operation"`
+	Count          int     `json:"count"`
+	Failed         int     `json:"failed"`
+	TotalSeconds   float64 `json:"total_seconds"`
+	AverageSeconds float64 `json:"average_seconds"`
+	MaxSeconds     float64 `json:"max_seconds"`
+}
+
+// NewIBMPIMetrics returns metrics written to the file at path, or nil when path is empty.
+func NewIBMPIMetrics(path string) *IBMPIMetrics {
+	if path == "" {
+		return nil
+	}
+	return &IBMPIMetrics{
+		path:       path,
+		operations: map[ibmpiOperation]*IBMPIOperationStats{},
+	}
+}
+
+// Record adds an operation of a resource type that took duration. Recording on nil metrics does
+// nothing, so callers do not have to check whether metrics are enabled.
+func (m *IBMPIMetrics) Record(resourceType, operation string, duration time.Duration, failed bool) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := ibmpiOperation{resourceType: resourceType, operation: operation}
+	stats, ok := m.operations[key]
+	if !ok {
+		stats = &IBMPIOperationStats{ResourceType: resourceType, Operation: operation}
+		m.operations[key] = stats
+	}
+	seconds := duration.Seconds()
+	stats.Count++
+	if failed {
+		stats.Failed++
+	}
+	stats.TotalSeconds += seconds
+	stats.AverageSeconds = stats.TotalSeconds / float64(stats.Count)
+	if seconds > stats.MaxSeconds {
+		stats.MaxSeconds = seconds
+	}
+	log.Printf("[INFO] %s %s took %s (failed: %t)", resourceType, operation, duration.Round(time.Millisecond), failed)
+
+	if err := m.write(); err != nil {
+		log.Printf("[WARN] failed to write the Power Systems metrics to %s: %v", m.path, err)
+	}
+}
+
+// Summary returns the statistics of each operation, sorted by resource type and operation.
+func (m *IBMPIMetrics) Summary() []IBMPIOperationStats {
+	if m == nil {
+		return nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.summary()
+}
+
+func (m *IBMPIMetrics) summary() []IBMPIOperationStats {
+	summary := make([]IBMPIOperationStats, 0, len(m.operations))
+	for _, stats := range m.operations {
+		summary = append(summary, *stats)
+	}
+	sort.Slice(summary, func(i, j int) bool {
+		if summary[i].ResourceType != summary[j].ResourceType {
+			return summary[i].ResourceType < summary[j].ResourceType
+		}
+		return summary[i].Operation < summary[j].Operation
+	})
+	return summary
+}
+
+// write replaces the file with the summary, through a temporary file so that a reader never
+// sees a partial summary.
+func (m *IBMPIMetrics) write() error {
+	data, err := json.MarshalIndent(struct {
+		Operations []IBMPIOperationStats `json:"operations"`
+	}{Operations: m.summary()}, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(m.path), filepath.Base(m.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), m.path)
+}
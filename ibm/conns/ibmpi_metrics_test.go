@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestIBMPIMetrics(t *testing.T) {
+	if NewIBMPIMetrics("") != nil {
+		t.Error("metrics are enabled without a file")
+	}
+	var disabled *IBMPIMetrics
+	disabled.Record("ibm_pi_instance", "create", time.Second, false)
+
+	path := filepath.Join(t.TempDir(), "metrics.json")
+	metrics := NewIBMPIMetrics(path)
+	metrics.Record("ibm_pi_volume", "create", 2*time.Second, false)
+	metrics.Record("ibm_pi_instance", "create", 4*time.Minute, false)
+	metrics.Record("ibm_pi_instance", "create", 6*time.Minute, true)
+	metrics.Record("ibm_pi_instance", "delete", time.Minute, false)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read the metrics file: %v", err)
+	}
+	var file struct {
+		Operations []IBMPIOperationStats `json:"operations"`
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		t.Fatalf("failed to parse the metrics file: %v", err)
+	}
+
+	want := []IBMPIOperationStats{
+		{ResourceType: "ibm_pi_instance", Operation: "create", Count: 2, Failed: 1, TotalSeconds: 600, AverageSeconds: 300, MaxSeconds: 360},
+		{ResourceType: "ibm_pi_instance", Operation: "delete", Count: 1, TotalSeconds: 60, AverageSeconds: 60, MaxSeconds: 60},
+		{ResourceType: "ibm_pi_volume", Operation: "create", Count: 1, TotalSeconds: 2, AverageSeconds: 2, MaxSeconds: 2},
+	}
+	if len(file.Operations) != len(want) {
+		t.Fatalf("operations = %+v, want %+v", file.Operations, want)
+	}
+	for i := range want {
+		if file.Operations[i] != want[i] {
+			t.Errorf("operation %d = %+v, want %+v", i, file.Operations[i], want[i])
+		}
+	}
+
+	matches, _ := filepath.Glob(path + ".*")
+	if len(matches) != 0 {
+		t.Errorf("temporary files were left behind: %v", matches)
+	}
+}
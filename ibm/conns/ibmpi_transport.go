@@ -0,0 +1,255 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime"
+)
+
+// ibmpiMaintenanceTransport sends the Power Systems operations that fail because the workspace
+// is in maintenance again once the maintenance should be over. The API answers them with a 503
+// that mentions the maintenance, and the request was not processed. It wraps the client transport
+// of the generated Power client rather than the HTTP transport, so each attempt gets the request
+// timeout of the SDK again, and the wait is only bounded by the context of the operation, which
+// has the timeout of the resource operation, d.Timeout(...).
+type ibmpiMaintenanceTransport struct {
+	next runtime.ClientTransport
+
+	// minWait and maxWait bound the wait between two attempts, so a missing or distant
+	// Retry-After still rechecks the workspace regularly.
+	minWait time.Duration
+	maxWait time.Duration
+}
+
+// ibmpiMaintenance is set in the context of each attempt, and records the maintenance response
+// that ibmpiMaintenanceRoundTripper found for it, if any.
+type ibmpiMaintenance struct {
+	found bool
+	until time.Time
+}
+
+type ibmpiMaintenanceKey struct{}
+
+func newIBMPIMaintenanceTransport(next runtime.ClientTransport) *ibmpiMaintenanceTransport {
+	return &ibmpiMaintenanceTransport{
+		next:    next,
+		minWait: 30 * time.Second,
+		maxWait: 5 * time.Minute,
+	}
+}
+
+func (t *ibmpiMaintenanceTransport) Submit(operation *runtime.ClientOperation) (interface{}, error) {
+	ctx := operation.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	for {
+		maintenance := &ibmpiMaintenance{}
+		attempt := *operation
+		attempt.Context = context.WithValue(ctx, ibmpiMaintenanceKey{}, maintenance)
+		result, err := t.next.Submit(&attempt)
+		if err == nil || !maintenance.found {
+			return result, err
+		}
+
+		message := "the workspace is in maintenance"
+		if !maintenance.until.IsZero() {
+			message += " until " + maintenance.until.UTC().Format(time.RFC3339)
+		}
+		// Without a deadline, the operation is not waited for
+		if _, ok := ctx.Deadline(); !ok {
+			return nil, fmt.Errorf("%s: %w", message, err)
+		}
+		wait := time.Until(maintenance.until)
+		if wait < t.minWait {
+			wait = t.minWait
+		}
+		if wait > t.maxWait {
+			wait = t.maxWait
+		}
+		log.Printf("[INFO] %s, retrying %s %s in %s", message, operation.Method, operation.PathPattern, wait)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, fmt.Errorf("%s: %w", message, err)
+		case <-timer.C:
+		}
+	}
+}
+
+// ibmpiMaintenanceRoundTripper records the maintenance responses of the requests sent by
+// ibmpiMaintenanceTransport, which decides whether to wait for the maintenance to end.
+type ibmpiMaintenanceRoundTripper struct {
+	next http.RoundTripper
+}
+
+func (t ibmpiMaintenanceRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusServiceUnavailable {
+		return resp, err
+	}
+	if maintenance, ok := req.Context().Value(ibmpiMaintenanceKey{}).(*ibmpiMaintenance); ok {
+		maintenance.until, maintenance.found = ibmpiMaintenanceUntil(resp)
+	}
+	return resp, nil
+}
+
+// ibmpiRetryTransport retries the Power Systems requests that fail with one of the retriable
+// status codes, such as 429 when the API throttles a large parallel apply, with an exponential
+// backoff. POST and PATCH requests can create objects, so they are only retried on 429, which the
+// API returns before it processes the request; a create is never sent twice.
+type ibmpiRetryTransport struct {
+	next        http.RoundTripper
+	maxRetries  int
+	backoff     time.Duration
+	maxBackoff  time.Duration
+	statusCodes map[int]bool
+}
+
+// ibmpiRetryStatusCodes are retried when the pi_retry_status_codes provider setting is not set.
+var ibmpiRetryStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// newIBMPIRetryTransport returns next when maxRetries is 0, so retries can be disabled.
+func newIBMPIRetryTransport(next http.RoundTripper, maxRetries int, backoff time.Duration, statusCodes []int) http.RoundTripper {
+	if maxRetries <= 0 {
+		return next
+	}
+	if len(statusCodes) == 0 {
+		statusCodes = ibmpiRetryStatusCodes
+	}
+	t := &ibmpiRetryTransport{
+		next:        next,
+		maxRetries:  maxRetries,
+		backoff:     backoff,
+		maxBackoff:  time.Minute,
+		statusCodes: map[int]bool{},
+	}
+	for _, code := range statusCodes {
+		t.statusCodes[code] = true
+	}
+	return t
+}
+
+func (t *ibmpiRetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	rewind, err := ibmpiRewindableBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	for attempt := 0; ; attempt++ {
+		rewind()
+		resp, err := t.next.RoundTrip(req)
+		if err != nil || attempt == t.maxRetries || !t.retriable(req, resp) {
+			return resp, err
+		}
+		io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+		resp.Body.Close()
+
+		wait := t.wait(attempt, resp)
+		log.Printf("[INFO] %s %s returned %d, retrying in %s (retry %d of %d)", req.Method, req.URL.Path, resp.StatusCode, wait, attempt+1, t.maxRetries)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, fmt.Errorf("%s %s returned %d: %w", req.Method, req.URL.Path, resp.StatusCode, req.Context().Err())
+		case <-timer.C:
+		}
+	}
+}
+
+func (t *ibmpiRetryTransport) retriable(req *http.Request, resp *http.Response) bool {
+	if !t.statusCodes[resp.StatusCode] {
+		return false
+	}
+	// ibmpiMaintenanceTransport waits for the end of the maintenance instead
+	if resp.StatusCode == http.StatusServiceUnavailable {
+		if _, ok := ibmpiMaintenanceUntil(resp); ok {
+			return false
+		}
+	}
+	switch req.Method {
+	case http.MethodPost, http.MethodPatch:
+		return resp.StatusCode == http.StatusTooManyRequests
+	}
+	return true
+}
+
+// wait returns the exponential backoff of the attempt with jitter, so parallel requests that were
+// throttled together are not sent again together, or the Retry-After of the response when it is
+// longer. Both are capped by maxBackoff.
+func (t *ibmpiRetryTransport) wait(attempt int, resp *http.Response) time.Duration {
+	wait := t.maxBackoff
+	if attempt < 30 && t.backoff<<attempt > 0 && t.backoff<<attempt < t.maxBackoff {
+		wait = t.backoff << attempt
+	}
+	wait = wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+
+	if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+		if retryAfter := time.Duration(seconds) * time.Second; retryAfter > wait {
+			wait = retryAfter
+		}
+	}
+	if wait > t.maxBackoff {
+		wait = t.maxBackoff
+	}
+	return wait
+}
+
+// ibmpiRewindableBody reads the body of the request once, and returns a function that sets a
+// new reader of it on the request before each attempt.
+func ibmpiRewindableBody(req *http.Request) (func(), error) {
+	if req.Body == nil {
+		return func() {}, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	return func() {
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}, nil
+}
+
+// ibmpiMaintenanceUntil reports if the 503 response is a maintenance response, and the end of
+// the maintenance from its Retry-After header, which is zero when the header is not set. The
+// body of the response is restored after it is read.
+func ibmpiMaintenanceUntil(resp *http.Response) (time.Time, bool) {
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil || !strings.Contains(strings.ToLower(string(body)), "maintenance") {
+		return time.Time{}, false
+	}
+
+	retryAfter := resp.Header.Get("Retry-After")
+	if seconds, err := strconv.Atoi(retryAfter); err == nil {
+		return time.Now().Add(time.Duration(seconds) * time.Second), true
+	}
+	if until, err := http.ParseTime(retryAfter); err == nil {
+		return until, true
+	}
+	return time.Time{}, true
+}
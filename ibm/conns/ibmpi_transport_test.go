@@ -0,0 +1,220 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/power/client"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_images"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/go-openapi/runtime"
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+func TestIBMPIMaintenanceTransport(t *testing.T) {
+	var mu sync.Mutex
+	var bodies []string
+	maintenance := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		b, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/unavailable/images"):
+			w.WriteHeader(http.StatusServiceUnavailable)
+			io.WriteString(w, `{"description":"service unavailable"}`)
+		case maintenance > 0 || strings.HasSuffix(r.URL.Path, "/maintenance/images"):
+			maintenance--
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			io.WriteString(w, `{"description":"The workspace is under maintenance"}`)
+		default:
+			w.WriteHeader(http.StatusCreated)
+			io.WriteString(w, `{"imageID":"image-1"}`)
+		}
+	}))
+	defer server.Close()
+
+	rt := httptransport.New(strings.TrimPrefix(server.URL, "http://"), "/", []string{"http"})
+	rt.Transport = ibmpiMaintenanceRoundTripper{next: http.DefaultTransport}
+	transport := newIBMPIMaintenanceTransport(rt)
+	transport.minWait = 100 * time.Millisecond
+	api := client.New(transport, strfmt.Default)
+
+	// Each request has a timeout shorter than the maintenance, as the SDK clients set with
+	// WithTimeout, so the maintenance must be waited for above the request timeout.
+	create := func(ctx context.Context, cloudInstanceID string) error {
+		imageID := "stock-image"
+		params := p_cloud_images.NewPcloudCloudinstancesImagesPostParams().
+			WithContext(ctx).WithTimeout(50 * time.Millisecond).
+			WithCloudInstanceID(cloudInstanceID).WithBody(&models.CreateImage{ImageID: imageID})
+		_, _, err := api.PCloudImages.PcloudCloudinstancesImagesPost(params, nil)
+		return err
+	}
+	reset := func(n int) {
+		mu.Lock()
+		defer mu.Unlock()
+		maintenance = n
+		bodies = nil
+	}
+
+	t.Run("retried", func(t *testing.T) {
+		reset(3)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := create(ctx, "workspace"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if len(bodies) != 4 || !strings.Contains(bodies[3], `"imageID":"stock-image"`) {
+			t.Errorf("bodies = %q, want the body sent four times", bodies)
+		}
+	})
+
+	t.Run("other 503", func(t *testing.T) {
+		reset(0)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		err := create(ctx, "unavailable")
+		if err == nil || strings.Contains(err.Error(), "maintenance") {
+			t.Errorf("error = %v, want the 503 error", err)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if len(bodies) != 1 {
+			t.Errorf("the request was sent %d times, want once", len(bodies))
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		reset(0)
+		ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+		defer cancel()
+		err := create(ctx, "maintenance")
+		if err == nil || !strings.Contains(err.Error(), "the workspace is in maintenance until") {
+			t.Errorf("error = %v, want a maintenance error", err)
+		}
+		var apiErr *runtime.APIError
+		if !errors.As(err, &apiErr) || apiErr.Code != http.StatusServiceUnavailable {
+			t.Errorf("error = %v, want it to wrap the 503 error", err)
+		}
+	})
+
+	t.Run("no deadline", func(t *testing.T) {
+		reset(0)
+		err := create(context.Background(), "maintenance")
+		if err == nil || !strings.Contains(err.Error(), "the workspace is in maintenance until") {
+			t.Errorf("error = %v, want a maintenance error", err)
+		}
+	})
+}
+
+func TestIBMPIRetryTransport(t *testing.T) {
+	var mu sync.Mutex
+	attempts := map[string]int{}
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		key := r.Method + " " + r.URL.Path
+		attempts[key]++
+		b, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		switch {
+		case r.URL.Path == "/throttled" && attempts[key] <= 2:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case r.URL.Path == "/throttled":
+			w.WriteHeader(http.StatusOK)
+		case r.URL.Path == "/bad-gateway":
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	send := func(t *testing.T, transport http.RoundTripper, method, path, body string) int {
+		req, _ := http.NewRequest(method, server.URL+path, strings.NewReader(body))
+		resp, err := (&http.Client{Transport: transport}).Do(req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+	reset := func() {
+		mu.Lock()
+		defer mu.Unlock()
+		attempts = map[string]int{}
+		bodies = nil
+	}
+	transport := newIBMPIRetryTransport(http.DefaultTransport, 3, time.Millisecond, nil)
+
+	tests := []struct {
+		name         string
+		transport    http.RoundTripper
+		method       string
+		path         string
+		wantStatus   int
+		wantAttempts int
+	}{
+		{name: "throttled create", transport: transport, method: http.MethodPost, path: "/throttled", wantStatus: http.StatusOK, wantAttempts: 3},
+		{name: "failed read", transport: transport, method: http.MethodGet, path: "/bad-gateway", wantStatus: http.StatusBadGateway, wantAttempts: 4},
+		{name: "failed create", transport: transport, method: http.MethodPost, path: "/bad-gateway", wantStatus: http.StatusBadGateway, wantAttempts: 1},
+		{name: "status not listed", transport: newIBMPIRetryTransport(http.DefaultTransport, 3, time.Millisecond, []int{429}), method: http.MethodGet, path: "/error", wantStatus: http.StatusInternalServerError, wantAttempts: 1},
+		{name: "disabled", transport: newIBMPIRetryTransport(http.DefaultTransport, 0, time.Millisecond, nil), method: http.MethodGet, path: "/throttled", wantStatus: http.StatusTooManyRequests, wantAttempts: 1},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			reset()
+			if status := send(t, tc.transport, tc.method, tc.path, `{"name":"vm"}`); status != tc.wantStatus {
+				t.Errorf("status = %d, want %d", status, tc.wantStatus)
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			if got := attempts[tc.method+" "+tc.path]; got != tc.wantAttempts {
+				t.Errorf("attempts = %d, want %d", got, tc.wantAttempts)
+			}
+			for _, b := range bodies {
+				if b != `{"name":"vm"}` {
+					t.Errorf("bodies = %q, want the body sent on each attempt", bodies)
+					break
+				}
+			}
+		})
+	}
+
+	t.Run("wait", func(t *testing.T) {
+		retry := newIBMPIRetryTransport(http.DefaultTransport, 10, 2*time.Second, nil).(*ibmpiRetryTransport)
+		resp := &http.Response{Header: http.Header{}}
+		if wait := retry.wait(0, resp); wait < time.Second || wait > 2*time.Second {
+			t.Errorf("first wait = %s, want between 1s and 2s", wait)
+		}
+		if wait := retry.wait(8, resp); wait < 30*time.Second || wait > time.Minute {
+			t.Errorf("ninth wait = %s, want between 30s and 1m", wait)
+		}
+		resp.Header.Set("Retry-After", "20")
+		if wait := retry.wait(0, resp); wait != 20*time.Second {
+			t.Errorf("wait = %s, want the 20s of Retry-After", wait)
+		}
+		resp.Header.Set("Retry-After", "600")
+		if wait := retry.wait(0, resp); wait != time.Minute {
+			t.Errorf("wait = %s, want the 1m cap", wait)
+		}
+	})
+}
@@ -9,11 +9,13 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -225,6 +227,48 @@ func Provider() *schema.Provider {
 				Description: "Path of the file that contains private and public regional endpoints mapping",
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_ENDPOINTS_FILE_PATH", "IBMCLOUD_ENDPOINTS_FILE_PATH"}, nil),
 			},
+			"pi_read_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The maximum number of Power Systems Virtual Server resources and data sources read at the same time; 0 means unlimited.",
+				DefaultFunc:  schema.MultiEnvDefaultFunc([]string{"IC_PI_READ_CONCURRENCY", "IBMCLOUD_PI_READ_CONCURRENCY"}, 0),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"pi_default_workspace_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The GUID of the Power Systems Virtual Server workspace used by Power resources and data sources that omit pi_cloud_instance_id.",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_PI_DEFAULT_WORKSPACE_ID", "IBMCLOUD_PI_DEFAULT_WORKSPACE_ID"}, ""),
+			},
+			"pi_metrics_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The path of a JSON file the durations of the create, update and delete operations of Power Systems Virtual Server resources are written to.",
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"IC_PI_METRICS_FILE", "IBMCLOUD_PI_METRICS_FILE"}, ""),
+			},
+			"pi_max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The number of times a Power Systems Virtual Server API request that failed with a retriable status is sent again; 0 disables the retries.",
+				DefaultFunc:  schema.MultiEnvDefaultFunc([]string{"IC_PI_MAX_RETRIES", "IBMCLOUD_PI_MAX_RETRIES"}, 3),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"pi_retry_backoff": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The number of seconds before the first retry of a Power Systems Virtual Server API request, doubled for each next retry up to 60 seconds.",
+				DefaultFunc:  schema.MultiEnvDefaultFunc([]string{"IC_PI_RETRY_BACKOFF", "IBMCLOUD_PI_RETRY_BACKOFF"}, 2),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"pi_retry_status_codes": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The HTTP status codes of the Power Systems Virtual Server API responses that are retried; defaults to 429, 500, 502, 503 and 504.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntBetween(400, 599),
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -622,13 +666,16 @@ func Provider() *schema.Provider {
 			// Added for Power Resources
 			"ibm_pi_available_hosts":                        power.DataSourceIBMPIAvailableHosts(),
 			"ibm_pi_catalog_images":                         power.DataSourceIBMPICatalogImages(),
+			"ibm_pi_catalog_offerings":                      power.DataSourceIBMPICatalogOfferings(),
 			"ibm_pi_cloud_connection":                       power.DataSourceIBMPICloudConnection(),
 			"ibm_pi_cloud_connections":                      power.DataSourceIBMPICloudConnections(),
 			"ibm_pi_cloud_instance":                         power.DataSourceIBMPICloudInstance(),
 			"ibm_pi_console_languages":                      power.DataSourceIBMPIInstanceConsoleLanguages(),
+			"ibm_pi_console_url":                            power.DataSourceIBMPIInstanceConsoleURL(),
 			"ibm_pi_datacenter":                             power.DataSourceIBMPIDatacenter(),
 			"ibm_pi_datacenters":                            power.DataSourceIBMPIDatacenters(),
 			"ibm_pi_dhcp":                                   power.DataSourceIBMPIDhcp(),
+			"ibm_pi_dhcp_leases":                            power.DataSourceIBMPIDhcpLeases(),
 			"ibm_pi_dhcps":                                  power.DataSourceIBMPIDhcps(),
 			"ibm_pi_disaster_recovery_location":             power.DataSourceIBMPIDisasterRecoveryLocation(),
 			"ibm_pi_disaster_recovery_locations":            power.DataSourceIBMPIDisasterRecoveryLocations(),
@@ -638,12 +685,16 @@ func Provider() *schema.Provider {
 			"ibm_pi_hosts":                                  power.DataSourceIBMPIHosts(),
 			"ibm_pi_image":                                  power.DataSourceIBMPIImage(),
 			"ibm_pi_images":                                 power.DataSourceIBMPIImages(),
+			"ibm_pi_import_blocks":                          power.DataSourceIBMPIImportBlocks(),
+			"ibm_pi_instance_action":                        power.DataSourceIBMPIInstanceAction(),
+			"ibm_pi_instance_capacity":                      power.DataSourceIBMPIInstanceCapacity(),
 			"ibm_pi_instance_ip":                            power.DataSourceIBMPIInstanceIP(),
 			"ibm_pi_instance_snapshot":                      power.DataSourceIBMPIInstanceSnapshot(),
 			"ibm_pi_instance_snapshots":                     power.DataSourceIBMPIInstanceSnapshots(),
 			"ibm_pi_instance_volumes":                       power.DataSourceIBMPIInstanceVolumes(),
 			"ibm_pi_instance":                               power.DataSourceIBMPIInstance(),
 			"ibm_pi_instances":                              power.DataSourceIBMPIInstances(),
+			"ibm_pi_jobs":                                   power.DataSourceIBMPIJobs(),
 			"ibm_pi_key":                                    power.DataSourceIBMPIKey(),
 			"ibm_pi_keys":                                   power.DataSourceIBMPIKeys(),
 			"ibm_pi_network_port":                           power.DataSourceIBMPINetworkPort(),
@@ -657,14 +708,18 @@ func Provider() *schema.Provider {
 			"ibm_pi_sap_profiles":                           power.DataSourceIBMPISAPProfiles(),
 			"ibm_pi_shared_processor_pool":                  power.DataSourceIBMPISharedProcessorPool(),
 			"ibm_pi_shared_processor_pools":                 power.DataSourceIBMPISharedProcessorPools(),
+			"ibm_pi_smallest_instance":                      power.DataSourceIBMPISmallestInstance(),
 			"ibm_pi_spp_placement_group":                    power.DataSourceIBMPISPPPlacementGroup(),
 			"ibm_pi_spp_placement_groups":                   power.DataSourceIBMPISPPPlacementGroups(),
 			"ibm_pi_storage_pool_capacity":                  power.DataSourceIBMPIStoragePoolCapacity(),
 			"ibm_pi_storage_pools_capacity":                 power.DataSourceIBMPIStoragePoolsCapacity(),
+			"ibm_pi_storage_tiers":                          power.DataSourceIBMPIStorageTiers(),
 			"ibm_pi_storage_type_capacity":                  power.DataSourceIBMPIStorageTypeCapacity(),
+			"ibm_pi_storage_types":                          power.DataSourceIBMPIStorageTypes(),
 			"ibm_pi_storage_types_capacity":                 power.DataSourceIBMPIStorageTypesCapacity(),
 			"ibm_pi_system_pools":                           power.DataSourceIBMPISystemPools(),
 			"ibm_pi_tenant":                                 power.DataSourceIBMPITenant(),
+			"ibm_pi_volume_attachments":                     power.DataSourceIBMPIVolumeAttachments(),
 			"ibm_pi_volume_clone":                           power.DataSourceIBMPIVolumeClone(),
 			"ibm_pi_volume_flash_copy_mappings":             power.DataSourceIBMPIVolumeFlashCopyMappings(),
 			"ibm_pi_volume_group_details":                   power.DataSourceIBMPIVolumeGroupDetails(),
@@ -773,6 +828,7 @@ func Provider() *schema.Provider {
 			// Catalog related resources
 			"ibm_cm_catalog":           catalogmanagement.DataSourceIBMCmCatalog(),
 			"ibm_cm_offering":          catalogmanagement.DataSourceIBMCmOffering(),
+			"ibm_cm_offering_versions": catalogmanagement.DataSourceIBMCmOfferingVersions(),
 			"ibm_cm_version":           catalogmanagement.DataSourceIBMCmVersion(),
 			"ibm_cm_offering_instance": catalogmanagement.DataSourceIBMCmOfferingInstance(),
 			"ibm_cm_preset":            catalogmanagement.DataSourceIBMCmPreset(),
@@ -1268,21 +1324,27 @@ func Provider() *schema.Provider {
 			"ibm_pi_image_export":                    power.ResourceIBMPIImageExport(),
 			"ibm_pi_image":                           power.ResourceIBMPIImage(),
 			"ibm_pi_instance_action":                 power.ResourceIBMPIInstanceAction(),
+			"ibm_pi_instance_power_schedule":         power.ResourceIBMPIInstancePowerSchedule(),
 			"ibm_pi_instance":                        power.ResourceIBMPIInstance(),
 			"ibm_pi_ipsec_policy":                    power.ResourceIBMPIIPSecPolicy(),
+			"ibm_pi_job_cleanup":                     power.ResourceIBMPIJobCleanup(),
 			"ibm_pi_key":                             power.ResourceIBMPIKey(),
+			"ibm_pi_network_address":                 power.ResourceIBMPINetworkAddress(),
 			"ibm_pi_network_port_attach":             power.ResourceIBMPINetworkPortAttach(),
 			"ibm_pi_network":                         power.ResourceIBMPINetwork(),
 			"ibm_pi_placement_group":                 power.ResourceIBMPIPlacementGroup(),
 			"ibm_pi_shared_processor_pool":           power.ResourceIBMPISharedProcessorPool(),
 			"ibm_pi_snapshot":                        power.ResourceIBMPISnapshot(),
+			"ibm_pi_snapshot_restore":                power.ResourceIBMPISnapshotRestore(),
 			"ibm_pi_spp_placement_group":             power.ResourceIBMPISPPPlacementGroup(),
 			"ibm_pi_volume_attach":                   power.ResourceIBMPIVolumeAttach(),
 			"ibm_pi_volume_clone":                    power.ResourceIBMPIVolumeClone(),
+			"ibm_pi_volume_delete_protection":        power.ResourceIBMPIVolumeDeleteProtection(),
 			"ibm_pi_volume_group_action":             power.ResourceIBMPIVolumeGroupAction(),
 			"ibm_pi_volume_group":                    power.ResourceIBMPIVolumeGroup(),
 			"ibm_pi_volume_onboarding":               power.ResourceIBMPIVolumeOnboarding(),
 			"ibm_pi_volume":                          power.ResourceIBMPIVolume(),
+			"ibm_pi_volumes":                         power.ResourceIBMPIVolumes(),
 			"ibm_pi_vpn_connection":                  power.ResourceIBMPIVPNConnection(),
 			"ibm_pi_workspace":                       power.ResourceIBMPIWorkspace(),
 
@@ -1542,10 +1604,16 @@ func wrapProvider(provider schema.Provider) schema.Provider {
 	wrappedDataSourcesMap := map[string]*schema.Resource{}
 
 	for key, value := range provider.ResourcesMap {
+		if strings.HasPrefix(key, "ibm_pi_") {
+			value = defaultPIWorkspace(value, false)
+		}
 		wrappedResourcesMap[key] = wrapResource(key, value)
 	}
 
 	for key, value := range provider.DataSourcesMap {
+		if strings.HasPrefix(key, "ibm_pi_") {
+			value = defaultPIWorkspace(value, true)
+		}
 		wrappedDataSourcesMap[key] = wrapDataSource(key, value)
 	}
 
@@ -1605,6 +1673,12 @@ func wrapFunction(
 	isDataSource bool,
 ) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
 	if function != nil {
+		if operationName == "read" && strings.HasPrefix(resourceName, "ibm_pi_") {
+			return limitPIRead(function)
+		}
+		if !isDataSource && strings.HasPrefix(resourceName, "ibm_pi_") {
+			return recordPIOperation(resourceName, operationName, function)
+		}
 		return func(context context.Context, schema *schema.ResourceData, meta interface{}) diag.Diagnostics {
 			return function(context, schema, meta)
 		}
@@ -1617,6 +1691,102 @@ func wrapFunction(
 	return nil
 }
 
+// limitPIRead bounds the number of Power Systems reads running at the same time to the
+// pi_read_concurrency provider setting, so large states refresh without flooding the API.
+func limitPIRead(function func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if sess, ok := meta.(conns.ClientSession); ok {
+			if limiter := sess.IBMPIReadLimiter(); limiter != nil {
+				select {
+				case limiter <- struct{}{}:
+					defer func() { <-limiter }()
+				case <-ctx.Done():
+					return diag.FromErr(ctx.Err())
+				}
+			}
+		}
+		return function(ctx, d, meta)
+	}
+}
+
+// recordPIOperation records the duration of a Power Systems resource operation in the metrics
+// enabled with the pi_metrics_file provider setting.
+func recordPIOperation(resourceName, operationName string, function func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		sess, ok := meta.(conns.ClientSession)
+		if !ok || sess.IBMPIMetrics() == nil {
+			return function(ctx, d, meta)
+		}
+		start := time.Now()
+		diags := function(ctx, d, meta)
+		sess.IBMPIMetrics().Record(resourceName, operationName, time.Since(start), diags.HasError())
+		return diags
+	}
+}
+
+// defaultPIWorkspace makes the pi_cloud_instance_id argument of a Power resource or data source
+// optional, falling back to the pi_default_workspace_id provider setting when it is omitted.
+// Resources take the default when they are created; afterwards the workspace in the state is kept.
+func defaultPIWorkspace(resource *schema.Resource, isDataSource bool) *schema.Resource {
+	const key = "pi_cloud_instance_id"
+	s, ok := resource.Schema[key]
+	if !ok || !s.Required || (isDataSource && resource.ReadContext == nil) {
+		return resource
+	}
+
+	optional := *s
+	optional.Required = false
+	optional.Optional = true
+	optional.Computed = true
+	optional.Description = strings.TrimSuffix(s.Description, ".") + ". Defaults to the provider pi_default_workspace_id."
+	wrapped := *resource
+	wrapped.Schema = make(map[string]*schema.Schema, len(resource.Schema))
+	for k, v := range resource.Schema {
+		wrapped.Schema[k] = v
+	}
+	wrapped.Schema[key] = &optional
+
+	defaultWorkspaceID := func(meta interface{}) (string, error) {
+		if sess, ok := meta.(conns.ClientSession); ok && sess.IBMPIDefaultWorkspaceID() != "" {
+			return sess.IBMPIDefaultWorkspaceID(), nil
+		}
+		return "", fmt.Errorf("%s is required unless the provider pi_default_workspace_id is set", key)
+	}
+
+	if isDataSource {
+		read := resource.ReadContext
+		wrapped.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			if d.Get(key).(string) == "" {
+				id, err := defaultWorkspaceID(meta)
+				if err != nil {
+					return diag.FromErr(err)
+				}
+				d.Set(key, id)
+			}
+			return read(ctx, d, meta)
+		}
+		return &wrapped
+	}
+
+	customizeDiff := resource.CustomizeDiff
+	wrapped.CustomizeDiff = func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if config := diff.GetRawConfig(); diff.Id() == "" && !config.IsNull() && config.GetAttr(key).IsNull() {
+			id, err := defaultWorkspaceID(meta)
+			if err != nil {
+				return err
+			}
+			if err := diff.SetNew(key, id); err != nil {
+				return err
+			}
+		}
+		if customizeDiff != nil {
+			return customizeDiff(ctx, diff, meta)
+		}
+		return nil
+	}
+	return &wrapped
+}
+
 func wrapError(err error, resourceName, operationName string, isDataSource bool) diag.Diagnostics {
 	if err == nil {
 		return nil
@@ -2129,6 +2299,15 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	retryCount := d.Get("max_retries").(int)
 	wskNameSpace := d.Get("function_namespace").(string)
 	riaasEndPoint := d.Get("riaas_endpoint").(string)
+	piReadConcurrency := d.Get("pi_read_concurrency").(int)
+	piDefaultWorkspaceID := d.Get("pi_default_workspace_id").(string)
+	piMetricsFile := d.Get("pi_metrics_file").(string)
+	piMaxRetries := d.Get("pi_max_retries").(int)
+	piRetryBackoff := d.Get("pi_retry_backoff").(int)
+	var piRetryStatusCodes []int
+	for _, code := range d.Get("pi_retry_status_codes").([]interface{}) {
+		piRetryStatusCodes = append(piRetryStatusCodes, code.(int))
+	}
 
 	wskEnvVal, err := schema.EnvDefaultFunc("FUNCTION_NAMESPACE", "")()
 	if err != nil {
@@ -2158,6 +2337,12 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		Visibility:           visibility,
 		EndpointsFile:        file,
 		IAMTrustedProfileID:  iamTrustedProfileId,
+		PIReadConcurrency:    piReadConcurrency,
+		PIDefaultWorkspaceID: piDefaultWorkspaceID,
+		PIMetricsFile:        piMetricsFile,
+		PIMaxRetries:         piMaxRetries,
+		PIRetryBackoff:       time.Duration(piRetryBackoff) * time.Second,
+		PIRetryStatusCodes:   piRetryStatusCodes,
 	}
 
 	return config.ClientSession()
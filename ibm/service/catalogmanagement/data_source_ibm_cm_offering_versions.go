@@ -0,0 +1,279 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package catalogmanagement
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/platform-services-go-sdk/catalogmanagementv1"
+)
+
+const validationStateValid = "valid"
+
+func DataSourceIBMCmOfferingVersions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMCmOfferingVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Catalog identifier.",
+			},
+			"offering_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Offering identifier.",
+			},
+			"kind_format": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list versions of the kind with this format (helm, operator, terraform, ova...).",
+			},
+			"include_deprecated": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether deprecated versions are included in the versions list.",
+			},
+			"versions": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of versions of the offering.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"version_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Unique ID.",
+						},
+						"version": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Version of content type.",
+						},
+						"version_locator": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A dotted value of `catalogID`.`versionID`.",
+						},
+						"kind_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Kind ID.",
+						},
+						"kind_format": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Content kind, e.g., helm, vm image.",
+						},
+						"target_kind": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Target platform, e.g., iks, terraform.",
+						},
+						"deprecated": &schema.Schema{
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "If set, denotes the version is deprecated.",
+						},
+						"deprecate_pending": &schema.Schema{
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The pending deprecation of the version; empty if none is pending.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"deprecate_date": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Date of deprecation.",
+									},
+									"deprecate_state": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Deprecation state.",
+									},
+									"description": &schema.Schema{
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"is_consumable": &schema.Schema{
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Denotes if the version can be consumed.",
+						},
+						"state": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Current state of the version; one of new, validated, consumable.",
+						},
+						"validation_state": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Current validation state - <empty>, in_progress, valid, invalid, expired.",
+						},
+						"validated": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Date and time of last successful validation.",
+						},
+						"created": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date and time this version was created.",
+						},
+					},
+				},
+			},
+			"latest_valid_version": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The highest version that is validated and neither deprecated nor pending deprecation; empty if there is none.",
+			},
+			"latest_valid_version_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the latest valid version.",
+			},
+			"latest_valid_version_locator": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version locator of the latest valid version.",
+			},
+		},
+	}
+}
+
+func dataSourceIBMCmOfferingVersionsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getOfferingOptions := &catalogmanagementv1.GetOfferingOptions{}
+
+	getOfferingOptions.SetCatalogIdentifier(d.Get("catalog_id").(string))
+	getOfferingOptions.SetOfferingID(d.Get("offering_id").(string))
+
+	offering, response, err := catalogManagementClient.GetOfferingWithContext(context, getOfferingOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetOfferingWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetOfferingWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(*getOfferingOptions.OfferingID)
+
+	kindFormat := d.Get("kind_format").(string)
+	includeDeprecated := d.Get("include_deprecated").(bool)
+
+	versions := []map[string]interface{}{}
+	var latest *catalogmanagementv1.Version
+	var latestSemver *version.Version
+	for _, kind := range offering.Kinds {
+		if kindFormat != "" && (kind.FormatKind == nil || *kind.FormatKind != kindFormat) {
+			continue
+		}
+		for i := range kind.Versions {
+			v := &kind.Versions[i]
+			deprecated := v.Deprecated != nil && *v.Deprecated
+			if deprecated && !includeDeprecated {
+				continue
+			}
+			versions = append(versions, dataSourceIBMCmOfferingVersionsVersionToMap(kind, v))
+
+			if deprecated || v.DeprecatePending != nil || v.Validation == nil || v.Validation.State == nil || *v.Validation.State != validationStateValid || v.Version == nil {
+				continue
+			}
+			semver, err := version.NewVersion(*v.Version)
+			if err != nil {
+				log.Printf("[DEBUG] Skipping version %s of offering %s that is not a semantic version: %s", *v.Version, *getOfferingOptions.OfferingID, err)
+				continue
+			}
+			if latestSemver == nil || semver.GreaterThan(latestSemver) {
+				latest, latestSemver = v, semver
+			}
+		}
+	}
+
+	if err = d.Set("versions", versions); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting versions: %s", err))
+	}
+
+	latestVersion, latestVersionID, latestVersionLocator := "", "", ""
+	if latest != nil {
+		latestVersion = *latest.Version
+		if latest.ID != nil {
+			latestVersionID = *latest.ID
+		}
+		if latest.VersionLocator != nil {
+			latestVersionLocator = *latest.VersionLocator
+		}
+	}
+	if err = d.Set("latest_valid_version", latestVersion); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting latest_valid_version: %s", err))
+	}
+	if err = d.Set("latest_valid_version_id", latestVersionID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting latest_valid_version_id: %s", err))
+	}
+	if err = d.Set("latest_valid_version_locator", latestVersionLocator); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting latest_valid_version_locator: %s", err))
+	}
+
+	return nil
+}
+
+func dataSourceIBMCmOfferingVersionsVersionToMap(kind catalogmanagementv1.Kind, v *catalogmanagementv1.Version) map[string]interface{} {
+	versionMap := map[string]interface{}{}
+
+	if v.ID != nil {
+		versionMap["version_id"] = *v.ID
+	}
+	if v.Version != nil {
+		versionMap["version"] = *v.Version
+	}
+	if v.VersionLocator != nil {
+		versionMap["version_locator"] = *v.VersionLocator
+	}
+	if kind.ID != nil {
+		versionMap["kind_id"] = *kind.ID
+	}
+	if kind.FormatKind != nil {
+		versionMap["kind_format"] = *kind.FormatKind
+	}
+	if kind.TargetKind != nil {
+		versionMap["target_kind"] = *kind.TargetKind
+	}
+	versionMap["deprecated"] = v.Deprecated != nil && *v.Deprecated
+	if v.DeprecatePending != nil {
+		deprecatePendingMap, _ := dataSourceIBMCmVersionDeprecatePendingToMap(v.DeprecatePending)
+		versionMap["deprecate_pending"] = []map[string]interface{}{deprecatePendingMap}
+	}
+	versionMap["is_consumable"] = v.IsConsumable != nil && *v.IsConsumable
+	if v.State != nil && v.State.Current != nil {
+		versionMap["state"] = *v.State.Current
+	}
+	if v.Validation != nil {
+		if v.Validation.State != nil {
+			versionMap["validation_state"] = *v.Validation.State
+		}
+		if v.Validation.Validated != nil {
+			versionMap["validated"] = v.Validation.Validated.String()
+		}
+	}
+	if v.Created != nil {
+		versionMap["created"] = v.Created.String()
+	}
+
+	return versionMap
+}
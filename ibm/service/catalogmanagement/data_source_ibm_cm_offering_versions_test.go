@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package catalogmanagement_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMCmOfferingVersionsDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCmOfferingVersionsDataSourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_cm_offering_versions.cm_offering_versions", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_cm_offering_versions.cm_offering_versions", "versions.#"),
+					resource.TestCheckResourceAttr("data.ibm_cm_offering_versions.cm_offering_versions", "versions.0.deprecated", "false"),
+					resource.TestCheckResourceAttr("data.ibm_cm_offering_versions.cm_offering_versions", "versions.0.kind_format", "terraform"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCmOfferingVersionsDataSourceConfigBasic() string {
+	return fmt.Sprintf(`
+		resource "ibm_cm_catalog" "cm_catalog" {
+			label = "test_basic_catalog_label_for_offering_versions_data_source"
+			kind = "offering"
+		}
+
+		resource "ibm_cm_offering" "cm_offering" {
+			catalog_id = ibm_cm_catalog.cm_catalog.id
+			label = "test_tf_offering_label_1"
+			name = "test_tf_offering_name_1"
+			offering_icon_url = "test.url.1"
+			tags = ["dev_ops"]
+		}
+
+		resource "ibm_cm_version" "cm_version" {
+			catalog_id = ibm_cm_catalog.cm_catalog.id
+			offering_id = ibm_cm_offering.cm_offering.id
+			zipurl = "https://github.com/IBM-Cloud/terraform-sample/archive/refs/tags/v1.1.0.tar.gz"
+			target_version = "1.1.0"
+			install {}
+		}
+
+		data "ibm_cm_offering_versions" "cm_offering_versions" {
+			catalog_id = ibm_cm_version.cm_version.catalog_id
+			offering_id = ibm_cm_version.cm_version.offering_id
+			kind_format = "terraform"
+		}
+	`)
+}
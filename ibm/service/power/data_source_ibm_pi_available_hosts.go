@@ -5,8 +5,10 @@ package power
 
 import (
 	"context"
+	"sort"
 
 	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/hashicorp/go-uuid"
@@ -27,6 +29,12 @@ func DataSourceIBMPIAvailableHosts() *schema.Resource {
 				Type:         schema.TypeString,
 				ValidateFunc: validation.NoZeroValues,
 			},
+			Arg_SysType: {
+				Description:  "Only list hosts of this system type, for example s1022.",
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
 			// Attributes
 			Attr_AvailableHosts: {
 				Computed:    true,
@@ -56,6 +64,11 @@ func DataSourceIBMPIAvailableHosts() *schema.Resource {
 					}},
 				Type: schema.TypeList,
 			},
+			Attr_TotalCount: {
+				Computed:    true,
+				Description: "The number of available hosts across all listed system types.",
+				Type:        schema.TypeInt,
+			},
 		},
 	}
 }
@@ -63,29 +76,59 @@ func DataSourceIBMPIAvailableHosts() *schema.Resource {
 func dataSourceIBMPIAvailableHostsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
 	hostClient := instance.NewIBMPIHostGroupsClient(ctx, sess, cloudInstanceID)
 	hostlist, err := hostClient.GetAvailableHosts()
 	if err != nil {
-		return diag.FromErr(err)
-	}
-	availableHosts := []map[string]interface{}{}
-	for _, value := range hostlist {
-		if value.Capacity != nil {
-			availableHosts = append(availableHosts, map[string]interface{}{
-				Attr_Count:           int(value.Count),
-				Attr_SysType:         value.SysType,
-				Attr_AvailableCores:  value.Capacity.Cores.Total,
-				Attr_AvailableMemory: value.Capacity.Memory.Total,
-			})
-		}
+		return piDiagFromErr(err)
 	}
+	availableHosts, total := flattenIBMPIAvailableHosts(hostlist, d.Get(Arg_SysType).(string))
 
 	var genID, _ = uuid.GenerateUUID()
 	d.SetId(genID)
 	d.Set(Attr_AvailableHosts, availableHosts)
+	d.Set(Attr_TotalCount, total)
 
 	return nil
 }
+
+// flattenIBMPIAvailableHosts returns the available hosts sorted by system type,
+// optionally limited to sysType, and the number of hosts they add up to.
+func flattenIBMPIAvailableHosts(hostlist models.AvailableHostList, sysType string) ([]map[string]interface{}, int) {
+	keys := make([]string, 0, len(hostlist))
+	for key, host := range hostlist {
+		if sysType == "" || host.SysType == sysType {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if hostlist[keys[i]].SysType != hostlist[keys[j]].SysType {
+			return hostlist[keys[i]].SysType < hostlist[keys[j]].SysType
+		}
+		return keys[i] < keys[j]
+	})
+
+	availableHosts := []map[string]interface{}{}
+	total := 0
+	for _, key := range keys {
+		host := hostlist[key]
+		if host.Capacity == nil {
+			continue
+		}
+		availableHost := map[string]interface{}{
+			Attr_Count:   int(host.Count),
+			Attr_SysType: host.SysType,
+		}
+		if host.Capacity.Cores != nil && host.Capacity.Cores.Total != nil {
+			availableHost[Attr_AvailableCores] = *host.Capacity.Cores.Total
+		}
+		if host.Capacity.Memory != nil && host.Capacity.Memory.Total != nil {
+			availableHost[Attr_AvailableMemory] = *host.Capacity.Memory.Total
+		}
+		availableHosts = append(availableHosts, availableHost)
+		total += int(host.Count)
+	}
+	return availableHosts, total
+}
@@ -21,6 +21,23 @@ func TestAccIBMPIAvailableHostsDataSourceBasic(t *testing.T) {
 				Config: testAccCheckIBMPIAvailableHostsDataSourceConfigBasic(),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.ibm_pi_available_hosts.pi_available_hosts_instance", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_pi_available_hosts.pi_available_hosts_instance", "total_count"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMPIAvailableHostsDataSourceSysType(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIAvailableHostsDataSourceConfigSysType("s1022"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_pi_available_hosts.pi_available_hosts_instance", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_pi_available_hosts.pi_available_hosts_instance", "total_count"),
 				),
 			},
 		},
@@ -34,3 +51,12 @@ func testAccCheckIBMPIAvailableHostsDataSourceConfigBasic() string {
 		}
 	`, acc.Pi_cloud_instance_id)
 }
+
+func testAccCheckIBMPIAvailableHostsDataSourceConfigSysType(sysType string) string {
+	return fmt.Sprintf(`
+		data "ibm_pi_available_hosts" "pi_available_hosts_instance" {
+			pi_cloud_instance_id = "%s"
+			pi_sys_type          = "%s"
+		}
+	`, acc.Pi_cloud_instance_id, sysType)
+}
@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"testing"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+)
+
+func testPIAvailableHost(sysType string, count int64, cores, memory float64) models.AvailableHost {
+	return models.AvailableHost{
+		Capacity: &models.AvailableHostCapacity{
+			Cores:  &models.AvailableHostResourceCapacity{Total: &cores},
+			Memory: &models.AvailableHostResourceCapacity{Total: &memory},
+		},
+		Count:   count,
+		SysType: sysType,
+	}
+}
+
+func TestFlattenIBMPIAvailableHosts(t *testing.T) {
+	hostlist := models.AvailableHostList{
+		"s1022": testPIAvailableHost("s1022", 3, 40, 2048),
+		"e980":  testPIAvailableHost("e980", 1, 120, 16384),
+		"s922":  {Capacity: &models.AvailableHostCapacity{}, Count: 2, SysType: "s922"},
+		"e1080": {Count: 4, SysType: "e1080"},
+	}
+
+	hosts, total := flattenIBMPIAvailableHosts(hostlist, "")
+	if total != 6 {
+		t.Errorf("total = %d, want 6", total)
+	}
+	var sysTypes []string
+	for _, host := range hosts {
+		sysTypes = append(sysTypes, host[Attr_SysType].(string))
+	}
+	if want := []string{"e980", "s1022", "s922"}; len(sysTypes) != len(want) || sysTypes[0] != want[0] || sysTypes[1] != want[1] || sysTypes[2] != want[2] {
+		t.Errorf("system types = %v, want %v", sysTypes, want)
+	}
+	if _, ok := hosts[2][Attr_AvailableCores]; ok {
+		t.Error("cores were set for a host without core capacity")
+	}
+
+	hosts, total = flattenIBMPIAvailableHosts(hostlist, "s1022")
+	if len(hosts) != 1 || total != 3 || hosts[0][Attr_AvailableCores] != 40.0 || hosts[0][Attr_AvailableMemory] != 2048.0 {
+		t.Errorf("filtered hosts = %v (total %d), want the s1022 hosts only", hosts, total)
+	}
+}
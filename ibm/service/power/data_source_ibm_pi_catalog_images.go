@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -135,7 +136,7 @@ func DataSourceIBMPICatalogImages() *schema.Resource {
 func dataSourceIBMPICatalogImagesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
@@ -144,11 +145,19 @@ func dataSourceIBMPICatalogImagesRead(ctx context.Context, d *schema.ResourceDat
 	imageC := instance.NewIBMPIImageClient(ctx, sess, cloudInstanceID)
 	stockImages, err := imageC.GetAllStockImages(includeSAP, includeVTL)
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
-	images := make([]map[string]interface{}, 0)
-	for _, i := range stockImages.Images {
+	images := flattenCatalogImages(stockImages.Images)
+	d.SetId(time.Now().UTC().String())
+	d.Set(Attr_Images, images)
+
+	return nil
+}
+
+func flattenCatalogImages(stockImages []*models.ImageReference) []map[string]interface{} {
+	images := make([]map[string]interface{}, 0, len(stockImages))
+	for _, i := range stockImages {
 		image := make(map[string]interface{})
 		image[Attr_ImageID] = *i.ImageID
 		image[Attr_Name] = *i.Name
@@ -200,8 +209,5 @@ func dataSourceIBMPICatalogImagesRead(ctx context.Context, d *schema.ResourceDat
 		}
 		images = append(images, image)
 	}
-	d.SetId(time.Now().UTC().String())
-	d.Set(Attr_Images, images)
-
-	return nil
+	return images
 }
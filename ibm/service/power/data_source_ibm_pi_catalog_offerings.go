@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"sync"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Datasource to list stock images, SAP profiles and system types of a workspace in one read
+func DataSourceIBMPICatalogOfferings() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMPICatalogOfferingsRead,
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_CloudInstanceID: {
+				Description:  "The GUID of the service instance associated with an account.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_SAP: {
+				Description: "Set true to include SAP images. The default value is false.",
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
+
+			// Attributes
+			Attr_Images:      DataSourceIBMPICatalogImages().Schema[Attr_Images],
+			Attr_SAPProfiles: DataSourceIBMPISAPProfiles().Schema[Attr_Profiles],
+			Attr_SystemTypes: {
+				Computed:    true,
+				Description: "List of system types available in the datacenter of the workspace.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Type:        schema.TypeList,
+			},
+		},
+	}
+}
+
+func dataSourceIBMPICatalogOfferingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	includeSAP := d.Get(Arg_SAP).(bool)
+
+	// The three lists are independent, so fetch them concurrently
+	var (
+		wg          sync.WaitGroup
+		stockImages *models.Images
+		sapProfiles *models.SAPProfiles
+		systemPools models.SystemPools
+		imageErr    error
+		sapErr      error
+		poolErr     error
+	)
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		stockImages, imageErr = instance.NewIBMPIImageClient(ctx, sess, cloudInstanceID).GetAllStockImages(includeSAP, false)
+		if imageErr != nil {
+			imageErr = fmt.Errorf("failed to get stock images: %w", imageErr)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		sapProfiles, sapErr = instance.NewIBMPISAPInstanceClient(ctx, sess, cloudInstanceID).GetAllSAPProfiles(cloudInstanceID)
+		if sapErr != nil {
+			sapErr = fmt.Errorf("failed to get SAP profiles: %w", sapErr)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		systemPools, poolErr = instance.NewIBMPISystemPoolClient(ctx, sess, cloudInstanceID).GetSystemPools()
+		if poolErr != nil {
+			poolErr = fmt.Errorf("failed to get system pools: %w", poolErr)
+		}
+	}()
+	wg.Wait()
+	if err := errors.Join(imageErr, sapErr, poolErr); err != nil {
+		log.Printf("[DEBUG] get catalog offerings failed %v", err)
+		return piDiagFromErr(err)
+	}
+
+	systemTypes := make([]string, 0, len(systemPools))
+	for _, sp := range systemPools {
+		if sp.Type != "" && !slices.Contains(systemTypes, sp.Type) {
+			systemTypes = append(systemTypes, sp.Type)
+		}
+	}
+	slices.Sort(systemTypes)
+
+	var genID, _ = uuid.GenerateUUID()
+	d.SetId(genID)
+	d.Set(Attr_Images, flattenCatalogImages(stockImages.Images))
+	d.Set(Attr_SAPProfiles, flattenSAPProfiles(sapProfiles.Profiles))
+	d.Set(Attr_SystemTypes, systemTypes)
+
+	return nil
+}
@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMPICatalogOfferingsDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPICatalogOfferingsDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_pi_catalog_offerings.offerings", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_pi_catalog_offerings.offerings", "images.#"),
+					resource.TestCheckResourceAttrSet("data.ibm_pi_catalog_offerings.offerings", "sap_profiles.#"),
+					resource.TestCheckResourceAttrSet("data.ibm_pi_catalog_offerings.offerings", "system_types.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPICatalogOfferingsDataSourceConfig() string {
+	return fmt.Sprintf(`
+		data "ibm_pi_catalog_offerings" "offerings" {
+			pi_cloud_instance_id = "%s"
+			sap                  = true
+		}`, acc.Pi_cloud_instance_id)
+}
@@ -6,6 +6,7 @@ package power
 import (
 	"context"
 	"log"
+	"net"
 
 	"github.com/IBM-Cloud/power-go-client/clients/instance"
 	"github.com/IBM-Cloud/power-go-client/power/models"
@@ -59,6 +60,11 @@ func DataSourceIBMPICloudConnection() *schema.Resource {
 				Description: "GRE auto-assigned source IP address.",
 				Type:        schema.TypeString,
 			},
+			Attr_IBMBGPNeighborAddress: {
+				Computed:    true,
+				Description: "The IBM BGP neighbor address, the IBM IP address without the prefix length.",
+				Type:        schema.TypeString,
+			},
 			Attr_IBMIPAddress: {
 				Computed:    true,
 				Description: "The IBM IP address.",
@@ -90,6 +96,11 @@ func DataSourceIBMPICloudConnection() *schema.Resource {
 				Description: "Link status.",
 				Type:        schema.TypeString,
 			},
+			Attr_UserBGPNeighborAddress: {
+				Computed:    true,
+				Description: "The user BGP neighbor address, the user IP address without the prefix length.",
+				Type:        schema.TypeString,
+			},
 			Attr_UserIPAddress: {
 				Computed:    true,
 				Description: "User IP address.",
@@ -113,7 +124,7 @@ func DataSourceIBMPICloudConnection() *schema.Resource {
 func dataSourceIBMPICloudConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
@@ -130,7 +141,7 @@ func dataSourceIBMPICloudConnectionRead(ctx context.Context, d *schema.ResourceD
 	cloudConnections, err := client.GetAll()
 	if err != nil {
 		log.Printf("[DEBUG] get cloud connections failed %v", err)
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	var cloudConnection *models.CloudConnection
 	if cloudConnections != nil {
@@ -149,7 +160,7 @@ func dataSourceIBMPICloudConnectionRead(ctx context.Context, d *schema.ResourceD
 	cloudConnection, err = client.Get(*cloudConnection.CloudConnectionID)
 	if err != nil {
 		log.Printf("[DEBUG] get cloud connection failed %v", err)
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	d.SetId(*cloudConnection.CloudConnectionID)
@@ -161,6 +172,12 @@ func dataSourceIBMPICloudConnectionRead(ctx context.Context, d *schema.ResourceD
 	d.Set(Attr_Metered, cloudConnection.Metered)
 	d.Set(Attr_IBMIPAddress, cloudConnection.IbmIPAddress)
 	d.Set(Attr_UserIPAddress, cloudConnection.UserIPAddress)
+	if cloudConnection.IbmIPAddress != nil {
+		d.Set(Attr_IBMBGPNeighborAddress, bgpNeighborAddress(*cloudConnection.IbmIPAddress))
+	}
+	if cloudConnection.UserIPAddress != nil {
+		d.Set(Attr_UserBGPNeighborAddress, bgpNeighborAddress(*cloudConnection.UserIPAddress))
+	}
 	d.Set(Attr_Status, cloudConnection.LinkStatus)
 	d.Set(Attr_Port, cloudConnection.Port)
 	d.Set(Attr_Speed, cloudConnection.Speed)
@@ -194,3 +211,12 @@ func dataSourceIBMPICloudConnectionRead(ctx context.Context, d *schema.ResourceD
 
 	return nil
 }
+
+// bgpNeighborAddress returns the host address of a cloud connection IP address, which the
+// API reports in CIDR notation, so it can be used directly as a BGP neighbor.
+func bgpNeighborAddress(address string) string {
+	if ip, _, err := net.ParseCIDR(address); err == nil {
+		return ip.String()
+	}
+	return address
+}
@@ -64,6 +64,11 @@ func DataSourceIBMPICloudConnections() *schema.Resource {
 							Description: "GRE auto-assigned source IP address.",
 							Type:        schema.TypeString,
 						},
+						Attr_IBMBGPNeighborAddress: {
+							Computed:    true,
+							Description: "The IBM BGP neighbor address, the IBM IP address without the prefix length.",
+							Type:        schema.TypeString,
+						},
 						Attr_IBMIPAddress: {
 							Computed:    true,
 							Description: "IBM IP address.",
@@ -100,6 +105,11 @@ func DataSourceIBMPICloudConnections() *schema.Resource {
 							Description: "Link status.",
 							Type:        schema.TypeString,
 						},
+						Attr_UserBGPNeighborAddress: {
+							Computed:    true,
+							Description: "The user BGP neighbor address, the user IP address without the prefix length.",
+							Type:        schema.TypeString,
+						},
 						Attr_UserIPAddress: {
 							Computed:    true,
 							Description: "User IP address.",
@@ -127,7 +137,7 @@ func DataSourceIBMPICloudConnections() *schema.Resource {
 func dataSourceIBMPICloudConnectionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
@@ -136,22 +146,24 @@ func dataSourceIBMPICloudConnectionsRead(ctx context.Context, d *schema.Resource
 	cloudConnections, err := client.GetAll()
 	if err != nil {
 		log.Printf("[DEBUG] get cloud connections failed %v", err)
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	result := make([]map[string]interface{}, 0, len(cloudConnections.CloudConnections))
 	for _, cloudConnection := range cloudConnections.CloudConnections {
 		cc := map[string]interface{}{
-			Attr_CloudConnectionID: *cloudConnection.CloudConnectionID,
-			Attr_ConnectionMode:    cloudConnection.ConnectionMode,
-			Attr_GlobalRouting:     *cloudConnection.GlobalRouting,
-			Attr_IBMIPAddress:      *cloudConnection.IbmIPAddress,
-			Attr_Metered:           *cloudConnection.Metered,
-			Attr_Name:              *cloudConnection.Name,
-			Attr_Port:              *cloudConnection.Port,
-			Attr_Speed:             *cloudConnection.Speed,
-			Attr_Status:            *cloudConnection.LinkStatus,
-			Attr_UserIPAddress:     *cloudConnection.UserIPAddress,
+			Attr_CloudConnectionID:      *cloudConnection.CloudConnectionID,
+			Attr_ConnectionMode:         cloudConnection.ConnectionMode,
+			Attr_GlobalRouting:          *cloudConnection.GlobalRouting,
+			Attr_IBMBGPNeighborAddress:  bgpNeighborAddress(*cloudConnection.IbmIPAddress),
+			Attr_IBMIPAddress:           *cloudConnection.IbmIPAddress,
+			Attr_Metered:                *cloudConnection.Metered,
+			Attr_Name:                   *cloudConnection.Name,
+			Attr_Port:                   *cloudConnection.Port,
+			Attr_Speed:                  *cloudConnection.Speed,
+			Attr_Status:                 *cloudConnection.LinkStatus,
+			Attr_UserBGPNeighborAddress: bgpNeighborAddress(*cloudConnection.UserIPAddress),
+			Attr_UserIPAddress:          *cloudConnection.UserIPAddress,
 		}
 
 		if cloudConnection.Networks != nil {
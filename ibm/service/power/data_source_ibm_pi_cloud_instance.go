@@ -119,7 +119,7 @@ func DataSourceIBMPICloudInstance() *schema.Resource {
 func dataSourceIBMPICloudInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
@@ -127,7 +127,7 @@ func dataSourceIBMPICloudInstanceRead(ctx context.Context, d *schema.ResourceDat
 	cloud_instance := instance.NewIBMPICloudInstanceClient(ctx, sess, cloudInstanceID)
 	cloud_instance_data, err := cloud_instance.Get(cloudInstanceID)
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	d.SetId(*cloud_instance_data.CloudInstanceID)
@@ -63,7 +63,7 @@ func DataSourceIBMPIDatacenter() *schema.Resource {
 func dataSourceIBMPIDatacenterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	datacenterZone := sess.Options.Zone
@@ -73,7 +73,7 @@ func dataSourceIBMPIDatacenterRead(ctx context.Context, d *schema.ResourceData,
 	client := instance.NewIBMPIDatacenterClient(ctx, sess, "")
 	dcData, err := client.Get(datacenterZone)
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	var genID, _ = uuid.GenerateUUID()
 	d.SetId(genID)
@@ -62,12 +62,12 @@ func DataSourceIBMPIDatacenters() *schema.Resource {
 func dataSourceIBMPIDatacentersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	client := instance.NewIBMPIDatacenterClient(ctx, sess, "")
 	datacentersData, err := client.GetAll()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	datacenters := make([]map[string]interface{}, 0, len(datacentersData.Datacenters))
 	for _, datacenter := range datacentersData.Datacenters {
@@ -80,7 +80,7 @@ func DataSourceIBMPIDhcp() *schema.Resource {
 func dataSourceIBMPIDhcpRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
@@ -89,7 +89,7 @@ func dataSourceIBMPIDhcpRead(ctx context.Context, d *schema.ResourceData, meta i
 	dhcpServer, err := client.Get(dhcpID)
 	if err != nil {
 		log.Printf("[DEBUG] get DHCP failed %v", err)
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, *dhcpServer.ID))
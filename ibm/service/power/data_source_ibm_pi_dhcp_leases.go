@@ -0,0 +1,148 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceIBMPIDhcpLeases() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMPIDhcpLeasesRead,
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_CloudInstanceID: {
+				Description:  "The GUID of the service instance associated with an account.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_DhcpID: {
+				Description:  "ID of the DHCP Server.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// Attributes
+			Attr_DhcpLeases: {
+				Computed:    true,
+				Description: "List of current leases of the DHCP Server.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_DhcpLeaseInstanceIP: {
+							Computed:    true,
+							Description: "IP of the PVM Instance.",
+							Type:        schema.TypeString,
+						},
+						Attr_DhcpLeaseInstanceMac: {
+							Computed:    true,
+							Description: "MAC Address of the PVM Instance.",
+							Type:        schema.TypeString,
+						},
+						Attr_PVMInstanceID: {
+							Computed:    true,
+							Description: "ID of the PVM Instance holding the lease; empty when no instance of the workspace has the MAC address.",
+							Type:        schema.TypeString,
+						},
+						Attr_ServerName: {
+							Computed:    true,
+							Description: "Name of the PVM Instance holding the lease; empty when no instance of the workspace has the MAC address.",
+							Type:        schema.TypeString,
+						},
+					},
+				},
+				Type: schema.TypeList,
+			},
+			Attr_DhcpNetworkID: {
+				Computed:    true,
+				Description: "ID of the DHCP Server private network.",
+				Type:        schema.TypeString,
+			},
+			Attr_NetworkName: {
+				Computed:    true,
+				Description: "Name of the DHCP Server private network.",
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func dataSourceIBMPIDhcpLeasesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	dhcpID := d.Get(Arg_DhcpID).(string)
+	client := instance.NewIBMPIDhcpClient(ctx, sess, cloudInstanceID)
+	dhcpServer, err := client.Get(dhcpID)
+	if err != nil {
+		log.Printf("[DEBUG] get DHCP failed %v", err)
+		return piDiagFromErr(err)
+	}
+
+	instanceClient := instance.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
+	pvms, err := instanceClient.GetAll()
+	if err != nil {
+		log.Printf("[DEBUG] get all instances failed %v", err)
+		return piDiagFromErr(err)
+	}
+	pvmsByMac := map[string]*models.PVMInstanceReference{}
+	for _, pvm := range pvms.PvmInstances {
+		if pvm == nil {
+			continue
+		}
+		for _, network := range pvm.Networks {
+			if network != nil && network.MacAddress != "" {
+				pvmsByMac[strings.ToLower(network.MacAddress)] = pvm
+			}
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, *dhcpServer.ID))
+
+	if dhcpServer.Network != nil {
+		if dhcpServer.Network.ID != nil {
+			d.Set(Attr_DhcpNetworkID, *dhcpServer.Network.ID)
+		}
+		if dhcpServer.Network.Name != nil {
+			d.Set(Attr_NetworkName, *dhcpServer.Network.Name)
+		}
+	}
+
+	leases := make([]map[string]interface{}, 0, len(dhcpServer.Leases))
+	for _, lease := range dhcpServer.Leases {
+		if lease == nil || lease.InstanceIP == nil || lease.InstanceMacAddress == nil {
+			continue
+		}
+		l := map[string]interface{}{
+			Attr_DhcpLeaseInstanceIP:  *lease.InstanceIP,
+			Attr_DhcpLeaseInstanceMac: *lease.InstanceMacAddress,
+		}
+		if pvm, ok := pvmsByMac[strings.ToLower(*lease.InstanceMacAddress)]; ok {
+			if pvm.PvmInstanceID != nil {
+				l[Attr_PVMInstanceID] = *pvm.PvmInstanceID
+			}
+			if pvm.ServerName != nil {
+				l[Attr_ServerName] = *pvm.ServerName
+			}
+		}
+		leases = append(leases, l)
+	}
+	d.Set(Attr_DhcpLeases, leases)
+
+	return nil
+}
@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMPIDhcpLeasesDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIDhcpLeasesDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_pi_dhcp_leases.leases", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_pi_dhcp_leases.leases", "network_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIDhcpLeasesDataSourceConfig() string {
+	return fmt.Sprintf(`
+		data "ibm_pi_dhcp_leases" "leases" {
+			pi_cloud_instance_id = "%s"
+			pi_dhcp_id           = "%s"
+		}`, acc.Pi_cloud_instance_id, acc.Pi_dhcp_id)
+}
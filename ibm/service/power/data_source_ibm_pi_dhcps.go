@@ -65,7 +65,7 @@ func DataSourceIBMPIDhcps() *schema.Resource {
 func dataSourceIBMPIDhcpServersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
@@ -73,7 +73,7 @@ func dataSourceIBMPIDhcpServersRead(ctx context.Context, d *schema.ResourceData,
 	dhcpServers, err := client.GetAll()
 	if err != nil {
 		log.Printf("[DEBUG] get all DHCP failed %v", err)
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	servers := make([]map[string]interface{}, 0, len(dhcpServers))
@@ -57,13 +57,13 @@ func DataSourceIBMPIDisasterRecoveryLocations() *schema.Resource {
 func dataSourceIBMPIDisasterRecoveryLocations(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	drClient := instance.NewIBMPIDisasterRecoveryLocationClient(ctx, sess, "")
 	drLocationSites, err := drClient.GetAll()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	results := make([]map[string]interface{}, 0, len(drLocationSites.DisasterRecoveryLocations))
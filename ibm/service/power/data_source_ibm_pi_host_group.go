@@ -72,7 +72,7 @@ func DataSourceIBMPIHostGroup() *schema.Resource {
 func dataSourceIBMPIHostGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
 	hostGroupID := d.Get(Arg_HostGroupID).(string)
@@ -80,7 +80,7 @@ func dataSourceIBMPIHostGroupRead(ctx context.Context, d *schema.ResourceData, m
 	hostGroup, err := client.GetHostGroup(hostGroupID)
 	if err != nil {
 		log.Printf("[DEBUG] get host group %v", err)
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	d.SetId(hostGroup.ID)
@@ -81,14 +81,14 @@ func DataSourceIBMPIHostGroups() *schema.Resource {
 func dataSourceIBMPIHostGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
 
 	client := instance.NewIBMPIHostGroupsClient(ctx, sess, cloudInstanceID)
 	hostGroups, err := client.GetHostGroups()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	var clientgenU, _ = uuid.GenerateUUID()
 	d.SetId(clientgenU)
@@ -0,0 +1,206 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Resource types that ibm_pi_import_blocks can generate import blocks for.
+const (
+	importResourceInstance = "ibm_pi_instance"
+	importResourceNetwork  = "ibm_pi_network"
+	importResourceVolume   = "ibm_pi_volume"
+)
+
+var importNameInvalidChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// importResource is an object of the workspace that can be imported.
+type importResource struct {
+	id           string
+	name         string
+	resourceType string
+}
+
+func DataSourceIBMPIImportBlocks() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMPIImportBlocksRead,
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_CloudInstanceID: {
+				Description:  "The GUID of the service instance associated with an account.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_ResourceTypes: {
+				Description: "The resource types to generate import blocks for. Defaults to all supported types.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{importResourceInstance, importResourceNetwork, importResourceVolume}, false),
+				},
+				Optional: true,
+				Type:     schema.TypeSet,
+			},
+
+			// Attributes
+			Attr_ImportBlocks: {
+				Computed:    true,
+				Description: "Terraform import blocks for all the resources, ready to be written to a configuration file.",
+				Type:        schema.TypeString,
+			},
+			Attr_Resources: {
+				Computed:    true,
+				Description: "List of resources that can be imported.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_Address: {
+							Computed:    true,
+							Description: "The resource address used in the import block.",
+							Type:        schema.TypeString,
+						},
+						Attr_ID: {
+							Computed:    true,
+							Description: "The import ID of the resource.",
+							Type:        schema.TypeString,
+						},
+						Attr_Name: {
+							Computed:    true,
+							Description: "The name of the object in the workspace.",
+							Type:        schema.TypeString,
+						},
+						Attr_Type: {
+							Computed:    true,
+							Description: "The resource type.",
+							Type:        schema.TypeString,
+						},
+					},
+				},
+				Type: schema.TypeList,
+			},
+		},
+	}
+}
+
+func dataSourceIBMPIImportBlocksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	types := map[string]bool{importResourceInstance: true, importResourceNetwork: true, importResourceVolume: true}
+	if v, ok := d.GetOk(Arg_ResourceTypes); ok {
+		types = map[string]bool{}
+		for _, t := range flex.ExpandStringList(v.(*schema.Set).List()) {
+			types[t] = true
+		}
+	}
+
+	var resources []importResource
+	if types[importResourceInstance] {
+		pvms, err := instance.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID).GetAll()
+		if err != nil {
+			log.Printf("[ERROR] get all instances failed %v", err)
+			return piDiagFromErr(err)
+		}
+		for _, pvm := range pvms.PvmInstances {
+			if pvm == nil || pvm.PvmInstanceID == nil || pvm.ServerName == nil {
+				continue
+			}
+			resources = append(resources, importResource{id: *pvm.PvmInstanceID, name: *pvm.ServerName, resourceType: importResourceInstance})
+		}
+	}
+	if types[importResourceNetwork] {
+		networks, err := instance.NewIBMPINetworkClient(ctx, sess, cloudInstanceID).GetAll()
+		if err != nil {
+			log.Printf("[ERROR] get all networks failed %v", err)
+			return piDiagFromErr(err)
+		}
+		for _, network := range networks.Networks {
+			if network == nil || network.NetworkID == nil || network.Name == nil {
+				continue
+			}
+			resources = append(resources, importResource{id: *network.NetworkID, name: *network.Name, resourceType: importResourceNetwork})
+		}
+	}
+	if types[importResourceVolume] {
+		volumes, err := instance.NewIBMPIVolumeClient(ctx, sess, cloudInstanceID).GetAll()
+		if err != nil {
+			log.Printf("[ERROR] get all volumes failed %v", err)
+			return piDiagFromErr(err)
+		}
+		for _, volume := range volumes.Volumes {
+			if volume == nil || volume.VolumeID == nil || volume.Name == nil {
+				continue
+			}
+			resources = append(resources, importResource{id: *volume.VolumeID, name: *volume.Name, resourceType: importResourceVolume})
+		}
+	}
+
+	var genID, _ = uuid.GenerateUUID()
+	d.SetId(genID)
+
+	flattened, blocks := flattenImportResources(cloudInstanceID, resources)
+	d.Set(Attr_ImportBlocks, blocks)
+	d.Set(Attr_Resources, flattened)
+
+	return nil
+}
+
+// flattenImportResources sorts the resources by type and name, gives each a unique resource
+// address derived from its name and returns them along with their import blocks.
+func flattenImportResources(cloudInstanceID string, resources []importResource) ([]map[string]interface{}, string) {
+	sort.SliceStable(resources, func(i, j int) bool {
+		if resources[i].resourceType != resources[j].resourceType {
+			return resources[i].resourceType < resources[j].resourceType
+		}
+		return resources[i].name < resources[j].name
+	})
+
+	var blocks strings.Builder
+	used := map[string]bool{}
+	result := make([]map[string]interface{}, 0, len(resources))
+	for _, r := range resources {
+		base := r.resourceType + "." + importResourceLabel(r.name)
+		address := base
+		for n := 2; used[address]; n++ {
+			address = fmt.Sprintf("%s_%d", base, n)
+		}
+		used[address] = true
+		id := cloudInstanceID + "/" + r.id
+
+		fmt.Fprintf(&blocks, "import {\n  to = %s\n  id = %q\n}\n\n", address, id)
+		result = append(result, map[string]interface{}{
+			Attr_Address: address,
+			Attr_ID:      id,
+			Attr_Name:    r.name,
+			Attr_Type:    r.resourceType,
+		})
+	}
+
+	return result, strings.TrimSuffix(blocks.String(), "\n")
+}
+
+// importResourceLabel turns the name of an object into a valid resource name label.
+func importResourceLabel(name string) string {
+	label := strings.Trim(importNameInvalidChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if label == "" || (label[0] >= '0' && label[0] <= '9') {
+		label = "pi_" + label
+	}
+	return label
+}
@@ -0,0 +1,38 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMPIImportBlocksDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIImportBlocksDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_pi_import_blocks.networks", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_pi_import_blocks.networks", "import_blocks"),
+					resource.TestCheckResourceAttr("data.ibm_pi_import_blocks.networks", "resources.0.type", "ibm_pi_network"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIImportBlocksDataSourceConfig() string {
+	return fmt.Sprintf(`
+		data "ibm_pi_import_blocks" "networks" {
+			pi_cloud_instance_id = "%s"
+			pi_resource_types    = ["ibm_pi_network"]
+		}`, acc.Pi_cloud_instance_id)
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -30,6 +31,7 @@ func DataSourceIBMPIInstance() *schema.Resource {
 				Type:         schema.TypeString,
 				ValidateFunc: validation.NoZeroValues,
 			},
+			Arg_WaitUntil: waitUntilSchema("The status of the instance to wait for, such as ACTIVE or SHUTOFF."),
 
 			// Attributes
 			Attr_DeploymentType: {
@@ -47,6 +49,11 @@ func DataSourceIBMPIInstance() *schema.Resource {
 				Description: "The health of the instance.",
 				Type:        schema.TypeString,
 			},
+			Attr_HostID: {
+				Computed:    true,
+				Description: "The ID of the host the instance is placed on, when exposed by the API.",
+				Type:        schema.TypeInt,
+			},
 			Attr_IBMiCSS: {
 				Computed:    true,
 				Description: "IBMi Cloud Storage Solution",
@@ -220,20 +227,32 @@ func DataSourceIBMPIInstance() *schema.Resource {
 func dataSourceIBMPIInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
 
 	powerC := instance.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
-	powervmdata, err := powerC.Get(d.Get(Arg_InstanceName).(string))
+	var powervmdata *models.PVMInstance
+	err = readWithWaitUntil(ctx, d, func() (string, error) {
+		var err error
+		powervmdata, err = powerC.Get(d.Get(Arg_InstanceName).(string))
+		if err != nil {
+			return "", err
+		}
+		if powervmdata.Status == nil {
+			return "", nil
+		}
+		return *powervmdata.Status, nil
+	})
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	pvminstanceid := *powervmdata.PvmInstanceID
 	d.SetId(pvminstanceid)
 	d.Set(Attr_DeploymentType, powervmdata.DeploymentType)
+	d.Set(Attr_HostID, powervmdata.HostID)
 	d.Set(Attr_LicenseRepositoryCapacity, powervmdata.LicenseRepositoryCapacity)
 	d.Set(Attr_MaxMem, powervmdata.Maxmem)
 	d.Set(Attr_MaxProc, powervmdata.Maxproc)
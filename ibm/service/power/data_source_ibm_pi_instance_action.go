@@ -0,0 +1,217 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/helpers"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_events"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// DataSourceIBMPIInstanceAction returns the recent actions performed on an instance, from the
+// events of the workspace, which record the user that initiated each of them.
+func DataSourceIBMPIInstanceAction() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMPIInstanceActionRead,
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_CloudInstanceID: {
+				Description:  "The GUID of the service instance associated with an account.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_FromTime: {
+				Description:  "Only list the actions performed from this time, in RFC 3339 format. The default is 24 hours before the data source is read.",
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			Arg_InstanceName: {
+				Description:  "The unique identifier or name of the instance.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_ToTime: {
+				Description:  "Only list the actions performed until this time, in RFC 3339 format.",
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			// Attributes
+			Attr_Actions: {
+				Computed:    true,
+				Description: "The actions performed on the instance, most recent first.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_Action: {
+							Computed:    true,
+							Description: "The action performed, such as start, stop or capture.",
+							Type:        schema.TypeString,
+						},
+						Attr_EventID: {
+							Computed:    true,
+							Description: "The ID of the event of the action.",
+							Type:        schema.TypeString,
+						},
+						Attr_Level: {
+							Computed:    true,
+							Description: "The level of the event: notice, info, warning or error.",
+							Type:        schema.TypeString,
+						},
+						Attr_Message: {
+							Computed:    true,
+							Description: "The message of the event.",
+							Type:        schema.TypeString,
+						},
+						Attr_Time: {
+							Computed:    true,
+							Description: "The time of the action, in RFC 3339 format.",
+							Type:        schema.TypeString,
+						},
+						Attr_UserEmail: {
+							Computed:    true,
+							Description: "The email of the user that initiated the action.",
+							Type:        schema.TypeString,
+						},
+						Attr_UserID: {
+							Computed:    true,
+							Description: "The ID of the user that initiated the action.",
+							Type:        schema.TypeString,
+						},
+						Attr_UserName: {
+							Computed:    true,
+							Description: "The name of the user that initiated the action.",
+							Type:        schema.TypeString,
+						},
+					},
+				},
+				Type: schema.TypeList,
+			},
+		},
+	}
+}
+
+func dataSourceIBMPIInstanceActionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	pvm, err := instance.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID).Get(d.Get(Arg_InstanceName).(string))
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+
+	fromTime := time.Now().Add(-24 * time.Hour).UTC().Format(time.RFC3339)
+	if v, ok := d.GetOk(Arg_FromTime); ok {
+		fromTime = v.(string)
+	}
+	// The instance clients of the SDK have no events client, so the generated client is used
+	params := p_cloud_events.NewPcloudEventsGetqueryParams().
+		WithContext(ctx).WithTimeout(helpers.PIGetTimeOut).
+		WithCloudInstanceID(cloudInstanceID).WithFromTime(&fromTime)
+	if v, ok := d.GetOk(Arg_ToTime); ok {
+		toTime := v.(string)
+		params = params.WithToTime(&toTime)
+	}
+	resp, err := sess.Power.PCloudEvents.PcloudEventsGetquery(params, sess.AuthInfo(cloudInstanceID))
+	if err != nil {
+		return piDiagFromErr(fmt.Errorf("failed to get the events of workspace %s: %w", cloudInstanceID, err))
+	}
+
+	d.SetId(*pvm.PvmInstanceID)
+	var events []*models.Event
+	if resp.Payload != nil {
+		events = resp.Payload.Events
+	}
+	d.Set(Attr_Actions, flattenIBMPIInstanceActions(events, *pvm.PvmInstanceID, flex.StringValue(pvm.ServerName)))
+
+	return nil
+}
+
+// flattenIBMPIInstanceActions returns the events of the instance, most recent first. The events
+// have no instance ID field: an event is of the instance when its metadata has the ID of the
+// instance, or when its message has the name of the instance as a whole word.
+func flattenIBMPIInstanceActions(events []*models.Event, id, name string) []map[string]interface{} {
+	namePattern := regexp.MustCompile(`(^|[^\w-])` + regexp.QuoteMeta(name) + `($|[^\w-])`)
+	matched := []*models.Event{}
+	for _, event := range events {
+		if event == nil {
+			continue
+		}
+		if ibmpiMetadataContains(event.Metadata, id) || (name != "" && event.Message != nil && namePattern.MatchString(*event.Message)) {
+			matched = append(matched, event)
+		}
+	}
+	sort.SliceStable(matched, func(i, j int) bool {
+		return ibmpiEventTimestamp(matched[i]) > ibmpiEventTimestamp(matched[j])
+	})
+
+	actions := make([]map[string]interface{}, 0, len(matched))
+	for _, event := range matched {
+		action := map[string]interface{}{
+			Attr_Action:  flex.StringValue(event.Action),
+			Attr_EventID: flex.StringValue(event.EventID),
+			Attr_Level:   flex.StringValue(event.Level),
+			Attr_Message: flex.StringValue(event.Message),
+		}
+		if event.Time != nil {
+			action[Attr_Time] = event.Time.String()
+		}
+		if event.User != nil {
+			action[Attr_UserEmail] = event.User.Email
+			action[Attr_UserID] = flex.StringValue(event.User.UserID)
+			action[Attr_UserName] = event.User.Name
+		}
+		actions = append(actions, action)
+	}
+	return actions
+}
+
+// ibmpiMetadataContains reports if the metadata of an event, decoded from JSON, has the value.
+func ibmpiMetadataContains(metadata interface{}, value string) bool {
+	switch m := metadata.(type) {
+	case string:
+		return m == value
+	case map[string]interface{}:
+		for _, v := range m {
+			if ibmpiMetadataContains(v, value) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, v := range m {
+			if ibmpiMetadataContains(v, value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func ibmpiEventTimestamp(event *models.Event) int64 {
+	if event.Timestamp != nil {
+		return *event.Timestamp
+	}
+	if event.Time != nil {
+		return time.Time(*event.Time).Unix()
+	}
+	return 0
+}
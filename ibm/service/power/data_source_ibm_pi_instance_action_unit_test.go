@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/power/internal/powertest"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataSourceIBMPIInstanceActionRead(t *testing.T) {
+	server := powertest.NewServer(t)
+	id, name := "pvm-1", "web"
+	server.AddInstance(&models.PVMInstance{PvmInstanceID: &id, ServerName: &name})
+
+	event := func(eventID, action, message string, timestamp int64, metadata interface{}, user *models.EventUser) *models.Event {
+		level := "info"
+		eventTime := strfmt.DateTime{}
+		return &models.Event{Action: &action, EventID: &eventID, Level: &level, Message: &message, Metadata: metadata, Time: &eventTime, Timestamp: &timestamp, User: user}
+	}
+	userID := "IBMid-1"
+	server.AddEvent(event("1", "start", "started", 100, map[string]interface{}{"pvmInstanceID": id}, &models.EventUser{UserID: &userID, Name: "Jo", Email: "jo@example.com"}))
+	server.AddEvent(event("2", "stop", "The pvm-instance 'web' was stopped", 300, nil, nil))
+	server.AddEvent(event("3", "stop", "The pvm-instance 'web-2' was stopped", 400, nil, nil))
+	server.AddEvent(event("4", "capture", "capture", 200, []interface{}{map[string]interface{}{"id": id}}, nil))
+	server.AddEvent(event("5", "delete", "deleted", 500, map[string]interface{}{"pvmInstanceID": "pvm-2"}, nil))
+	server.AddEvent(nil)
+
+	d := schema.TestResourceDataRaw(t, DataSourceIBMPIInstanceAction().Schema, map[string]interface{}{
+		Arg_CloudInstanceID: powertest.CloudInstanceID,
+		Arg_InstanceName:    id,
+		Arg_ToTime:          "2024-01-02T00:00:00Z",
+	})
+	if diags := dataSourceIBMPIInstanceActionRead(context.Background(), d, server.Meta(t)); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	var eventIDs []string
+	for _, action := range d.Get(Attr_Actions).([]interface{}) {
+		eventIDs = append(eventIDs, action.(map[string]interface{})[Attr_EventID].(string))
+	}
+	if want := []string{"2", "4", "1"}; !reflect.DeepEqual(eventIDs, want) {
+		t.Errorf("event IDs = %v, want %v", eventIDs, want)
+	}
+	if got := d.Get(Attr_Actions + ".2." + Attr_UserName).(string); got != "Jo" {
+		t.Errorf("%s = %q, want Jo", Attr_UserName, got)
+	}
+	if got := d.Get(Attr_Actions + ".2." + Attr_UserEmail).(string); got != "jo@example.com" {
+		t.Errorf("%s = %q, want jo@example.com", Attr_UserEmail, got)
+	}
+
+	var query string
+	for _, request := range server.Requests() {
+		if strings.HasPrefix(request, "GET events?") {
+			query = request
+		}
+	}
+	if !strings.Contains(query, "from_time=") || !strings.Contains(query, "to_time=2024-01-02T00%3A00%3A00Z") {
+		t.Errorf("events request = %q, want the from and to times", query)
+	}
+}
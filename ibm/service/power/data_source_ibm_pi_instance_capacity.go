@@ -0,0 +1,159 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"log"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	limitingDimensionCores          = "cores"
+	limitingDimensionCoresAndMemory = "cores_and_memory"
+	limitingDimensionMemory         = "memory"
+	limitingDimensionSysType        = "sys_type"
+)
+
+func DataSourceIBMPIInstanceCapacity() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMPIInstanceCapacityRead,
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_CloudInstanceID: {
+				Description:  "The GUID of the service instance associated with an account.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_Memory: {
+				Description:  "The amount of memory (GB) the instance needs.",
+				Required:     true,
+				Type:         schema.TypeFloat,
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+			Arg_Processors: {
+				Description:  "The number of processors (cores) the instance needs.",
+				Required:     true,
+				Type:         schema.TypeFloat,
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+			Arg_SysType: {
+				Description:  "The type of system the instance needs, for example s922 or e980.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// Attributes
+			Attr_CanHost: {
+				Computed:    true,
+				Description: "Indicates if a host in the system pool currently has enough available cores and memory for the instance.",
+				Type:        schema.TypeBool,
+			},
+			Attr_LimitingDimension: {
+				Computed:    true,
+				Description: "The dimension that prevents the instance from being hosted; empty when it can be hosted.",
+				Type:        schema.TypeString,
+			},
+			Attr_MaxCoresAvailable: {
+				Computed:    true,
+				Description: "Maximum configurable cores available on a single host of the system pool.",
+				Type:        schema.TypeFloat,
+			},
+			Attr_MaxMemoryAvailable: {
+				Computed:    true,
+				Description: "Maximum configurable memory (GB) available on a single host of the system pool.",
+				Type:        schema.TypeInt,
+			},
+			Attr_SystemPoolName: {
+				Computed:    true,
+				Description: "The system pool name matching the requested system type.",
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func dataSourceIBMPIInstanceCapacityRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	memory := d.Get(Arg_Memory).(float64)
+	processors := d.Get(Arg_Processors).(float64)
+	sysType := d.Get(Arg_SysType).(string)
+
+	client := instance.NewIBMPISystemPoolClient(ctx, sess, cloudInstanceID)
+	sps, err := client.GetSystemPools()
+	if err != nil {
+		log.Printf("[ERROR] get system pools capacity failed %v", err)
+		return piDiagFromErr(err)
+	}
+
+	var genID, _ = uuid.GenerateUUID()
+	d.SetId(genID)
+
+	sp, ok := sps[sysType]
+	if !ok {
+		d.Set(Attr_CanHost, false)
+		d.Set(Attr_LimitingDimension, limitingDimensionSysType)
+		d.Set(Attr_MaxCoresAvailable, 0)
+		d.Set(Attr_MaxMemoryAvailable, 0)
+		d.Set(Attr_SystemPoolName, "")
+		return nil
+	}
+
+	maxCores, maxMemory, limitingDimension := checkSystemPoolCapacity(sp, processors, memory)
+	d.Set(Attr_CanHost, limitingDimension == "")
+	d.Set(Attr_LimitingDimension, limitingDimension)
+	d.Set(Attr_MaxCoresAvailable, maxCores)
+	d.Set(Attr_MaxMemoryAvailable, maxMemory)
+	d.Set(Attr_SystemPoolName, sysType)
+
+	return nil
+}
+
+// checkSystemPoolCapacity looks for a single host of the system pool with enough available cores and
+// memory. It returns the largest available cores and memory of any host and, when no host fits, the
+// dimension that is short.
+func checkSystemPoolCapacity(sp models.SystemPool, processors, memory float64) (float64, int64, string) {
+	var maxCores float64
+	var maxMemory int64
+	fits := false
+	for _, s := range sp.Systems {
+		if s == nil || s.Cores == nil || s.Memory == nil {
+			continue
+		}
+		if *s.Cores > maxCores {
+			maxCores = *s.Cores
+		}
+		if *s.Memory > maxMemory {
+			maxMemory = *s.Memory
+		}
+		if *s.Cores >= processors && float64(*s.Memory) >= memory {
+			fits = true
+		}
+	}
+
+	switch {
+	case fits:
+		return maxCores, maxMemory, ""
+	case maxCores < processors && float64(maxMemory) >= memory:
+		return maxCores, maxMemory, limitingDimensionCores
+	case maxCores >= processors && float64(maxMemory) < memory:
+		return maxCores, maxMemory, limitingDimensionMemory
+	default:
+		return maxCores, maxMemory, limitingDimensionCoresAndMemory
+	}
+}
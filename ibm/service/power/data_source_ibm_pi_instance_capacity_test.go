@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMPIInstanceCapacityDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIInstanceCapacityDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_pi_instance_capacity.capacity", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_pi_instance_capacity.capacity", "can_host"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIInstanceCapacityDataSourceConfig() string {
+	return fmt.Sprintf(`
+		data "ibm_pi_instance_capacity" "capacity" {
+			pi_cloud_instance_id = "%s"
+			pi_memory            = 2
+			pi_processors        = 0.25
+			pi_sys_type          = "s922"
+		}`, acc.Pi_cloud_instance_id)
+}
@@ -60,7 +60,7 @@ func DataSourceIBMPIInstanceConsoleLanguages() *schema.Resource {
 func dataSourceIBMPIInstanceConsoleLanguagesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
@@ -69,7 +69,7 @@ func dataSourceIBMPIInstanceConsoleLanguagesRead(ctx context.Context, d *schema.
 	client := instance.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
 	languages, err := client.GetConsoleLanguages(instanceName)
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	var clientgenU, _ = uuid.GenerateUUID()
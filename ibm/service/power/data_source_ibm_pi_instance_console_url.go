@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Datasource to generate a console URL for an instance. Each read generates a new URL.
+func DataSourceIBMPIInstanceConsoleURL() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMPIInstanceConsoleURLRead,
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_CloudInstanceID: {
+				Description:  "The GUID of the service instance associated with an account.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_InstanceName: {
+				Description:  "The unique identifier or name of the instance.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// Attributes
+			Attr_ConsoleURL: {
+				Computed:    true,
+				Description: "The URL of the serial console of the instance. It is generated on every read.",
+				Sensitive:   true,
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func dataSourceIBMPIInstanceConsoleURLRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	instanceName := d.Get(Arg_InstanceName).(string)
+
+	client := instance.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
+	console, err := client.PostConsoleURL(instanceName)
+	if err != nil {
+		log.Printf("[DEBUG] generate console URL failed %v", err)
+		return piDiagFromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, instanceName))
+	d.Set(Attr_ConsoleURL, console.ConsoleURL)
+
+	return nil
+}
@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMPIInstanceConsoleURL(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIInstanceConsoleURLConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_pi_console_url.example", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_pi_console_url.example", "console_url"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIInstanceConsoleURLConfig() string {
+	return fmt.Sprintf(`
+		data "ibm_pi_console_url" "example" {
+			pi_cloud_instance_id = "%s"
+			pi_instance_name     = "%s"
+		}`, acc.Pi_cloud_instance_id, acc.Pi_instance_name)
+}
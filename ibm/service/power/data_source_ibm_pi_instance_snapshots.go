@@ -6,6 +6,9 @@ package power
 import (
 	"context"
 	"log"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/IBM-Cloud/power-go-client/clients/instance"
 	"github.com/IBM-Cloud/power-go-client/power/models"
@@ -27,6 +30,9 @@ func DataSourceIBMPIInstanceSnapshots() *schema.Resource {
 				Type:         schema.TypeString,
 				ValidateFunc: validation.NoZeroValues,
 			},
+			Arg_NameRegex: snapshotFilterSchema()[Arg_NameRegex],
+			Arg_OlderThan: snapshotFilterSchema()[Arg_OlderThan],
+			Arg_Status:    snapshotFilterSchema()[Arg_Status],
 
 			// Attributes
 			Attr_InstanceSnapshots: {
@@ -90,23 +96,91 @@ func DataSourceIBMPIInstanceSnapshots() *schema.Resource {
 func dataSourceIBMPIInstanceSnapshotsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
 	snapshot := instance.NewIBMPISnapshotClient(ctx, sess, cloudInstanceID)
 	snapshotData, err := snapshot.GetAll()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
+	}
+	snapshots, err := filterSnapshots(d, snapshotData.Snapshots, time.Now())
+	if err != nil {
+		return piDiagFromErr(err)
 	}
 
 	var clientgenU, _ = uuid.GenerateUUID()
 	d.SetId(clientgenU)
-	d.Set(Attr_InstanceSnapshots, flattenSnapshotsInstances(snapshotData.Snapshots))
+	d.Set(Attr_InstanceSnapshots, flattenSnapshotsInstances(snapshots))
 
 	return nil
 }
 
+// snapshotFilterSchema returns the arguments that filter the snapshots returned by the snapshot list
+// data sources, for example to collect candidates for deletion by a retention policy.
+func snapshotFilterSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		Arg_NameRegex: {
+			Description:  "Regular expression the snapshot names must match.",
+			Optional:     true,
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringIsValidRegExp,
+		},
+		Arg_OlderThan: {
+			Description:  "Only return snapshots created longer ago than this duration, for example 720h.",
+			Optional:     true,
+			Type:         schema.TypeString,
+			ValidateFunc: validateDuration,
+		},
+		Arg_Status: {
+			Description:  "Only return snapshots with this status, for example available or error.",
+			Optional:     true,
+			Type:         schema.TypeString,
+			ValidateFunc: validation.NoZeroValues,
+		},
+	}
+}
+
+// filterSnapshots returns the snapshots that match the filter arguments of the data source.
+// Snapshots without a creation date are left out when pi_older_than is set.
+func filterSnapshots(d *schema.ResourceData, list []*models.Snapshot, now time.Time) ([]*models.Snapshot, error) {
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk(Arg_NameRegex); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+	var cutoff time.Time
+	if v, ok := d.GetOk(Arg_OlderThan); ok {
+		age, err := time.ParseDuration(v.(string))
+		if err != nil {
+			return nil, err
+		}
+		cutoff = now.Add(-age)
+	}
+	status := d.Get(Arg_Status).(string)
+
+	result := make([]*models.Snapshot, 0, len(list))
+	for _, i := range list {
+		if i == nil || i.SnapshotID == nil || i.Name == nil {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(*i.Name) {
+			continue
+		}
+		if status != "" && !strings.EqualFold(i.Status, status) {
+			continue
+		}
+		if !cutoff.IsZero() {
+			created := time.Time(i.CreationDate)
+			if created.IsZero() || !created.Before(cutoff) {
+				continue
+			}
+		}
+		result = append(result, i)
+	}
+	return result, nil
+}
+
 func flattenSnapshotsInstances(list []*models.Snapshot) []map[string]interface{} {
 	log.Printf("Calling the flattenSnapshotsInstances call with list %d", len(list))
 	result := make([]map[string]interface{}, 0, len(list))
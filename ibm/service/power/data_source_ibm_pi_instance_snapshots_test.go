@@ -27,6 +27,32 @@ func TestAccIBMPIInstanceSnapshotsDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMPIInstanceSnapshotsDataSourceFilters(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIInstanceSnapshotsDataSourceFiltersConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_pi_instance_snapshots.testacc_ds_snapshots", "id"),
+					resource.TestCheckResourceAttr("data.ibm_pi_instance_snapshots.testacc_ds_snapshots", "instance_snapshots.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIInstanceSnapshotsDataSourceFiltersConfig() string {
+	return fmt.Sprintf(`
+		data "ibm_pi_instance_snapshots" "testacc_ds_snapshots" {
+			pi_cloud_instance_id = "%s"
+			pi_name_regex        = "^tf-no-such-snapshot-"
+			pi_older_than        = "720h"
+			pi_status            = "available"
+		}`, acc.Pi_cloud_instance_id)
+}
+
 func testAccCheckIBMPIInstanceSnapshotsDataSourceConfig() string {
 	return fmt.Sprintf(`
 		data "ibm_pi_instance_snapshots" "testacc_ds_snapshots" {
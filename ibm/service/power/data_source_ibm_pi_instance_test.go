@@ -27,6 +27,22 @@ func TestAccIBMPIInstanceDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMPIInstanceDataSource_waitUntil(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIInstanceDataSourceWaitUntilConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_pi_instance.testacc_ds_instance", "id"),
+					resource.TestCheckResourceAttr("data.ibm_pi_instance.testacc_ds_instance", "status", "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMPIInstanceDataSourceConfig() string {
 	return fmt.Sprintf(`
 		data "ibm_pi_instance" "testacc_ds_instance" {
@@ -34,3 +50,15 @@ func testAccCheckIBMPIInstanceDataSourceConfig() string {
 			pi_cloud_instance_id = "%s"
 		}`, acc.Pi_instance_name, acc.Pi_cloud_instance_id)
 }
+
+func testAccCheckIBMPIInstanceDataSourceWaitUntilConfig() string {
+	return fmt.Sprintf(`
+		data "ibm_pi_instance" "testacc_ds_instance" {
+			pi_instance_name="%s"
+			pi_cloud_instance_id = "%s"
+			pi_wait_until {
+				status  = "ACTIVE"
+				timeout = "20m"
+			}
+		}`, acc.Pi_instance_name, acc.Pi_cloud_instance_id)
+}
@@ -44,6 +44,11 @@ func DataSourceIBMPIInstances() *schema.Resource {
 							Description: "The health of the instance.",
 							Type:        schema.TypeString,
 						},
+						Attr_HostID: {
+							Computed:    true,
+							Description: "The ID of the host the instance is placed on, when exposed by the API.",
+							Type:        schema.TypeInt,
+						},
 						Attr_LicenseRepositoryCapacity: {
 							Computed:    true,
 							Deprecated:  "This field is deprecated.",
@@ -200,7 +205,7 @@ func dataSourceIBMPIInstancesAllRead(ctx context.Context, d *schema.ResourceData
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
@@ -209,7 +214,7 @@ func dataSourceIBMPIInstancesAllRead(ctx context.Context, d *schema.ResourceData
 	powervmdata, err := powerC.GetAll()
 
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	var clientgenU, _ = uuid.GenerateUUID()
@@ -223,6 +228,7 @@ func flattenPvmInstances(list []*models.PVMInstanceReference) []map[string]inter
 	result := make([]map[string]interface{}, 0, len(list))
 	for _, i := range list {
 		l := map[string]interface{}{
+			Attr_HostID:                    i.HostID,
 			Attr_LicenseRepositoryCapacity: i.LicenseRepositoryCapacity,
 			Attr_MaxMem:                    i.Maxmem,
 			Attr_MaxProc:                   i.Maxproc,
@@ -0,0 +1,192 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"log"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceIBMPIJobs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMPIJobsRead,
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_CloudInstanceID: {
+				Description:  "The GUID of the service instance associated with an account.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_JobState: {
+				Description:  "Only list jobs in this state, for example completed, failed or running.",
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_OperationAction: {
+				Description:  "Only list jobs of this operation action, for example vmCapture or imageExport.",
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_OperationTarget: {
+				Description:  "Only list jobs of this operation target, for example vm or image.",
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// Attributes
+			Attr_Jobs: {
+				Computed:    true,
+				Description: "List of jobs.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_CreateTimestamp: {
+							Computed:    true,
+							Description: "The timestamp when the job is created.",
+							Type:        schema.TypeString,
+						},
+						Attr_ID: {
+							Computed:    true,
+							Description: "The unique identifier of the job.",
+							Type:        schema.TypeString,
+						},
+						Attr_Operation: {
+							Computed:    true,
+							Description: "Operation details of the job.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									Attr_Action: {
+										Computed:    true,
+										Description: "Name of the operation.",
+										Type:        schema.TypeString,
+									},
+									Attr_ID: {
+										Computed:    true,
+										Description: "ID of the operation.",
+										Type:        schema.TypeString,
+									},
+									Attr_Target: {
+										Computed:    true,
+										Description: "Name of the target of the operation.",
+										Type:        schema.TypeString,
+									},
+								},
+							},
+							Type: schema.TypeList,
+						},
+						Attr_Status: {
+							Computed:    true,
+							Description: "Status of the job.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									Attr_Message: {
+										Computed:    true,
+										Description: "Message of the job.",
+										Type:        schema.TypeString,
+									},
+									Attr_Progress: {
+										Computed:    true,
+										Description: "Progress of the job.",
+										Type:        schema.TypeString,
+									},
+									Attr_State: {
+										Computed:    true,
+										Description: "State of the job.",
+										Type:        schema.TypeString,
+									},
+								},
+							},
+							Type: schema.TypeList,
+						},
+					},
+				},
+				Type: schema.TypeList,
+			},
+		},
+	}
+}
+
+func dataSourceIBMPIJobsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	client := instance.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
+	jobs, err := client.GetAll()
+	if err != nil {
+		log.Printf("[ERROR] get all jobs failed %v", err)
+		return piDiagFromErr(err)
+	}
+
+	var genID, _ = uuid.GenerateUUID()
+	d.SetId(genID)
+
+	state := d.Get(Arg_JobState).(string)
+	action := d.Get(Arg_OperationAction).(string)
+	target := d.Get(Arg_OperationTarget).(string)
+	d.Set(Attr_Jobs, flattenJobs(filterJobs(jobs.Jobs, state, action, target)))
+
+	return nil
+}
+
+// filterJobs returns the jobs matching the given status state, operation action and operation
+// target. Empty values match any job.
+func filterJobs(jobs []*models.Job, state, action, target string) []*models.Job {
+	filtered := make([]*models.Job, 0, len(jobs))
+	for _, job := range jobs {
+		if job == nil {
+			continue
+		}
+		if state != "" && (job.Status == nil || job.Status.State == nil || *job.Status.State != state) {
+			continue
+		}
+		if action != "" && (job.Operation == nil || job.Operation.Action == nil || *job.Operation.Action != action) {
+			continue
+		}
+		if target != "" && (job.Operation == nil || job.Operation.Target == nil || *job.Operation.Target != target) {
+			continue
+		}
+		filtered = append(filtered, job)
+	}
+	return filtered
+}
+
+func flattenJobs(jobs []*models.Job) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(jobs))
+	for _, job := range jobs {
+		j := map[string]interface{}{
+			Attr_CreateTimestamp: job.CreateTimestamp.String(),
+			Attr_ID:              job.ID,
+		}
+		if job.Operation != nil {
+			j[Attr_Operation] = []map[string]interface{}{{
+				Attr_Action: job.Operation.Action,
+				Attr_ID:     job.Operation.ID,
+				Attr_Target: job.Operation.Target,
+			}}
+		}
+		if job.Status != nil {
+			j[Attr_Status] = []map[string]interface{}{{
+				Attr_Message:  job.Status.Message,
+				Attr_Progress: job.Status.Progress,
+				Attr_State:    job.Status.State,
+			}}
+		}
+		result = append(result, j)
+	}
+	return result
+}
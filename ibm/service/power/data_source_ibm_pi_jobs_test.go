@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMPIJobsDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIJobsDataSourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_pi_jobs.jobs", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_pi_jobs.jobs", "jobs.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIJobsDataSourceConfigBasic() string {
+	return fmt.Sprintf(`
+		data "ibm_pi_jobs" "jobs" {
+			pi_cloud_instance_id = "%s"
+			pi_job_state         = "completed"
+		}`, acc.Pi_cloud_instance_id)
+}
@@ -59,7 +59,7 @@ func DataSourceIBMPIKeys() *schema.Resource {
 func dataSourceIBMPIKeysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
@@ -68,7 +68,7 @@ func dataSourceIBMPIKeysRead(ctx context.Context, d *schema.ResourceData, meta i
 	sshKeys, err := client.GetAll()
 	if err != nil {
 		log.Printf("[ERROR] get all keys failed %v", err)
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	result := make([]map[string]interface{}, 0, len(sshKeys.SSHKeys))
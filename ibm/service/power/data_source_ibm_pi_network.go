@@ -5,9 +5,12 @@ package power
 
 import (
 	"context"
+	"fmt"
+	"net"
+	"strings"
 
 	"github.com/IBM-Cloud/power-go-client/clients/instance"
-	"github.com/IBM-Cloud/power-go-client/helpers"
+	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -19,6 +22,13 @@ func DataSourceIBMPINetwork() *schema.Resource {
 		ReadContext: dataSourceIBMPINetworkRead,
 		Schema: map[string]*schema.Schema{
 			// Arguments
+			Arg_CIDR: {
+				Description:  "The CIDR of the network to look up.",
+				ExactlyOneOf: []string{Arg_CIDR, Arg_NetworkName, Arg_VLanID},
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsCIDR,
+			},
 			Arg_CloudInstanceID: {
 				Description:  "The GUID of the service instance associated with an account.",
 				Required:     true,
@@ -26,11 +36,21 @@ func DataSourceIBMPINetwork() *schema.Resource {
 				ValidateFunc: validation.NoZeroValues,
 			},
 			Arg_NetworkName: {
+				Computed:     true,
 				Description:  "The unique identifier or name of a network.",
-				Required:     true,
+				ExactlyOneOf: []string{Arg_CIDR, Arg_NetworkName, Arg_VLanID},
+				Optional:     true,
 				Type:         schema.TypeString,
 				ValidateFunc: validation.NoZeroValues,
 			},
+			Arg_VLanID: {
+				Description:  "The VLAN ID of the network to look up.",
+				ExactlyOneOf: []string{Arg_CIDR, Arg_NetworkName, Arg_VLanID},
+				Optional:     true,
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			Arg_WaitUntil: waitUntilSchema(""),
 
 			// Attributes
 			Attr_AccessConfig: {
@@ -103,15 +123,20 @@ func DataSourceIBMPINetwork() *schema.Resource {
 func dataSourceIBMPINetworkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
 
 	networkC := instance.NewIBMPINetworkClient(ctx, sess, cloudInstanceID)
-	networkdata, err := networkC.Get(d.Get(helpers.PINetworkName).(string))
+	var networkdata *models.Network
+	err = readWithWaitUntil(ctx, d, func() (string, error) {
+		var err error
+		networkdata, err = getIBMPINetworkByArgs(d, networkC)
+		return "", err
+	})
 	if err != nil || networkdata == nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	d.SetId(*networkdata.NetworkID)
@@ -130,6 +155,9 @@ func dataSourceIBMPINetworkRead(ctx context.Context, d *schema.ResourceData, met
 	d.Set(Attr_MTU, networkdata.Mtu)
 	if networkdata.Name != nil {
 		d.Set(Attr_Name, networkdata.Name)
+		if _, ok := d.GetOk(Arg_NetworkName); !ok {
+			d.Set(Arg_NetworkName, networkdata.Name)
+		}
 	}
 	if networkdata.Type != nil {
 		d.Set(Attr_Type, networkdata.Type)
@@ -146,3 +174,70 @@ func dataSourceIBMPINetworkRead(ctx context.Context, d *schema.ResourceData, met
 
 	return nil
 }
+
+// getIBMPINetworkByArgs gets the network given by pi_network_name, or the only network of the
+// workspace with the CIDR in pi_cidr or the VLAN ID in pi_vlan_id. The errors for networks that
+// are not found say so, for pi_wait_until to retry them.
+func getIBMPINetworkByArgs(d *schema.ResourceData, client *instance.IBMPINetworkClient) (*models.Network, error) {
+	if v, ok := d.GetOk(Arg_NetworkName); ok {
+		return client.Get(v.(string))
+	}
+
+	networks, err := client.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []*models.Network
+	if v, ok := d.GetOk(Arg_VLanID); ok {
+		for _, ref := range networks.Networks {
+			if ref == nil || ref.NetworkID == nil || ref.VlanID == nil || int(*ref.VlanID) != v.(int) {
+				continue
+			}
+			network, err := client.Get(*ref.NetworkID)
+			if err != nil {
+				return nil, err
+			}
+			matched = append(matched, network)
+		}
+		return singleIBMPINetwork(matched, fmt.Sprintf("%s %d", Arg_VLanID, v.(int)))
+	}
+
+	// The network references have no CIDR, so every network is read
+	_, cidr, err := net.ParseCIDR(d.Get(Arg_CIDR).(string))
+	if err != nil {
+		return nil, err
+	}
+	for _, ref := range networks.Networks {
+		if ref == nil || ref.NetworkID == nil {
+			continue
+		}
+		network, err := client.Get(*ref.NetworkID)
+		if err != nil {
+			return nil, err
+		}
+		if network.Cidr == nil {
+			continue
+		}
+		if _, networkCIDR, err := net.ParseCIDR(*network.Cidr); err == nil && networkCIDR.String() == cidr.String() {
+			matched = append(matched, network)
+		}
+	}
+	return singleIBMPINetwork(matched, fmt.Sprintf("%s %s", Arg_CIDR, cidr))
+}
+
+func singleIBMPINetwork(matched []*models.Network, lookup string) (*models.Network, error) {
+	switch len(matched) {
+	case 0:
+		return nil, fmt.Errorf("network with %s %w", lookup, errPINotFound)
+	case 1:
+		return matched[0], nil
+	}
+	names := make([]string, 0, len(matched))
+	for _, network := range matched {
+		if network.Name != nil {
+			names = append(names, *network.Name)
+		}
+	}
+	return nil, fmt.Errorf("%d networks match %s: %s; use %s instead", len(matched), lookup, strings.Join(names, ", "), Arg_NetworkName)
+}
@@ -86,7 +86,7 @@ func DataSourceIBMPINetworkPort() *schema.Resource {
 func dataSourceIBMPINetworkPortsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
@@ -94,7 +94,7 @@ func dataSourceIBMPINetworkPortsRead(ctx context.Context, d *schema.ResourceData
 	networkportC := instance.NewIBMPINetworkClient(ctx, sess, cloudInstanceID)
 	networkportdata, err := networkportC.GetAllPorts(d.Get(helpers.PINetworkName).(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	var clientgenU, _ = uuid.GenerateUUID()
 	d.SetId(clientgenU)
@@ -34,3 +34,33 @@ func testAccCheckIBMPINetworkDataSourceConfig() string {
 			pi_cloud_instance_id = "%s"
 		}`, acc.Pi_network_name, acc.Pi_cloud_instance_id)
 }
+
+func TestAccIBMPINetworkDataSource_cidrAndVLAN(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPINetworkDataSourceCIDRAndVLANConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.ibm_pi_network.by_cidr", "id", "data.ibm_pi_network.testacc_ds_network", "id"),
+					resource.TestCheckResourceAttrPair("data.ibm_pi_network.by_cidr", "pi_network_name", "data.ibm_pi_network.testacc_ds_network", "name"),
+					resource.TestCheckResourceAttrPair("data.ibm_pi_network.by_vlan", "id", "data.ibm_pi_network.testacc_ds_network", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPINetworkDataSourceCIDRAndVLANConfig() string {
+	return testAccCheckIBMPINetworkDataSourceConfig() + fmt.Sprintf(`
+		data "ibm_pi_network" "by_cidr" {
+			pi_cidr = data.ibm_pi_network.testacc_ds_network.cidr
+			pi_cloud_instance_id = "%[1]s"
+		}
+
+		data "ibm_pi_network" "by_vlan" {
+			pi_cloud_instance_id = "%[1]s"
+			pi_vlan_id = data.ibm_pi_network.testacc_ds_network.vlan_id
+		}`, acc.Pi_cloud_instance_id)
+}
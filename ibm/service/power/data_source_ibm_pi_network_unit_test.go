@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/power/internal/powertest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testPINetwork(id, cidr string, vlanID float64) *models.Network {
+	name := "net-" + id
+	return &models.Network{
+		Cidr:      &cidr,
+		Name:      &name,
+		NetworkID: &id,
+		VlanID:    &vlanID,
+	}
+}
+
+func TestGetIBMPINetworkByArgs(t *testing.T) {
+	server := powertest.NewServer(t)
+	client := instance.NewIBMPINetworkClient(context.Background(), server.Session(t), powertest.CloudInstanceID)
+	server.AddNetwork(testPINetwork("a", "10.0.0.0/24", 100))
+	server.AddNetwork(testPINetwork("b", "10.0.1.0/24", 200))
+	server.AddNetwork(testPINetwork("c", "10.0.1.0/24", 300))
+
+	tests := []struct {
+		name    string
+		args    map[string]interface{}
+		wantID  string
+		wantErr string
+	}{
+		{name: "name", args: map[string]interface{}{Arg_NetworkName: "net-b"}, wantID: "b"},
+		{name: "cidr", args: map[string]interface{}{Arg_CIDR: "10.0.0.0/24"}, wantID: "a"},
+		{name: "cidr host address", args: map[string]interface{}{Arg_CIDR: "10.0.0.7/24"}, wantID: "a"},
+		{name: "cidr ambiguous", args: map[string]interface{}{Arg_CIDR: "10.0.1.0/24"}, wantErr: "2 networks match"},
+		{name: "cidr missing", args: map[string]interface{}{Arg_CIDR: "10.0.2.0/24"}, wantErr: NotFound},
+		{name: "vlan", args: map[string]interface{}{Arg_VLanID: 300}, wantID: "c"},
+		{name: "vlan missing", args: map[string]interface{}{Arg_VLanID: 400}, wantErr: NotFound},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.args[Arg_CloudInstanceID] = powertest.CloudInstanceID
+			d := schema.TestResourceDataRaw(t, DataSourceIBMPINetwork().Schema, tc.args)
+
+			network, err := getIBMPINetworkByArgs(d, client)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Errorf("error = %v, want it to contain %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if *network.NetworkID != tc.wantID {
+				t.Errorf("network = %s, want %s", *network.NetworkID, tc.wantID)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"log"
 
 	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -26,6 +27,12 @@ func DataSourceIBMPIPlacementGroups() *schema.Resource {
 				Type:         schema.TypeString,
 				ValidateFunc: validation.NoZeroValues,
 			},
+			Arg_ExpandMembers: {
+				Default:     false,
+				Description: "Indicates whether to include the instance details of each member of the placement groups.",
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
 
 			// Attributes
 			Attr_PlacementGroups: {
@@ -38,6 +45,35 @@ func DataSourceIBMPIPlacementGroups() *schema.Resource {
 							Description: "The ID of the placement group.",
 							Type:        schema.TypeString,
 						},
+						Attr_MemberInstances: {
+							Computed:    true,
+							Description: "List of server instances that are members of the placement group, only set when pi_expand_members is true.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									Attr_HostID: {
+										Computed:    true,
+										Description: "The ID of the host the instance is placed on, when exposed by the API.",
+										Type:        schema.TypeInt,
+									},
+									Attr_ID: {
+										Computed:    true,
+										Description: "The ID of the instance.",
+										Type:        schema.TypeString,
+									},
+									Attr_Name: {
+										Computed:    true,
+										Description: "The name of the instance.",
+										Type:        schema.TypeString,
+									},
+									Attr_Status: {
+										Computed:    true,
+										Description: "The status of the instance.",
+										Type:        schema.TypeString,
+									},
+								},
+							},
+							Type: schema.TypeList,
+						},
 						Attr_Members: {
 							Computed:    true,
 							Description: "List of server instances IDs that are members of the placement group.",
@@ -64,7 +100,7 @@ func DataSourceIBMPIPlacementGroups() *schema.Resource {
 func dataSourceIBMPIPlacementGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
@@ -73,7 +109,23 @@ func dataSourceIBMPIPlacementGroupsRead(ctx context.Context, d *schema.ResourceD
 	groups, err := client.GetAll()
 	if err != nil {
 		log.Printf("[ERROR] get all placement groups failed %v", err)
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
+	}
+
+	var pvmInstances map[string]*models.PVMInstanceReference
+	if d.Get(Arg_ExpandMembers).(bool) {
+		instanceClient := instance.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
+		pvms, err := instanceClient.GetAll()
+		if err != nil {
+			log.Printf("[ERROR] get all instances failed %v", err)
+			return piDiagFromErr(err)
+		}
+		pvmInstances = make(map[string]*models.PVMInstanceReference, len(pvms.PvmInstances))
+		for _, pvm := range pvms.PvmInstances {
+			if pvm != nil && pvm.PvmInstanceID != nil {
+				pvmInstances[*pvm.PvmInstanceID] = pvm
+			}
+		}
 	}
 
 	result := make([]map[string]interface{}, 0, len(groups.PlacementGroups))
@@ -84,6 +136,9 @@ func dataSourceIBMPIPlacementGroupsRead(ctx context.Context, d *schema.ResourceD
 			Attr_Name:    placementGroup.Name,
 			Attr_Policy:  placementGroup.Policy,
 		}
+		if pvmInstances != nil {
+			key[Attr_MemberInstances] = flattenPlacementGroupMemberInstances(placementGroup.Members, pvmInstances)
+		}
 		result = append(result, key)
 	}
 
@@ -93,3 +148,23 @@ func dataSourceIBMPIPlacementGroupsRead(ctx context.Context, d *schema.ResourceD
 
 	return nil
 }
+
+func flattenPlacementGroupMemberInstances(members []string, pvmInstances map[string]*models.PVMInstanceReference) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(members))
+	for _, member := range members {
+		m := map[string]interface{}{
+			Attr_ID: member,
+		}
+		if pvm, ok := pvmInstances[member]; ok {
+			m[Attr_HostID] = pvm.HostID
+			if pvm.ServerName != nil {
+				m[Attr_Name] = *pvm.ServerName
+			}
+			if pvm.Status != nil {
+				m[Attr_Status] = *pvm.Status
+			}
+		}
+		result = append(result, m)
+	}
+	return result
+}
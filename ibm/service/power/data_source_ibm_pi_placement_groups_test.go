@@ -26,9 +26,33 @@ func TestAccIBMPIPlacementGrousDataSourceBasic(t *testing.T) {
 	})
 }
 
+func TestAccIBMPIPlacementGrousDataSourceExpandMembers(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIPlacementGrousDataSourceExpandMembersConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_pi_placement_groups.test", "id"),
+					resource.TestCheckResourceAttr("data.ibm_pi_placement_groups.test", "pi_expand_members", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMPIPlacementGrousDataSourceConfig() string {
 	return fmt.Sprintf(`
 		data "ibm_pi_placement_groups" "test" {
 			pi_cloud_instance_id = "%s"
 		}`, acc.Pi_cloud_instance_id)
 }
+
+func testAccCheckIBMPIPlacementGrousDataSourceExpandMembersConfig() string {
+	return fmt.Sprintf(`
+		data "ibm_pi_placement_groups" "test" {
+			pi_cloud_instance_id = "%s"
+			pi_expand_members    = true
+		}`, acc.Pi_cloud_instance_id)
+}
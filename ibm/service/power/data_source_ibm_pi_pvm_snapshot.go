@@ -6,6 +6,7 @@ package power
 import (
 	"context"
 	"log"
+	"time"
 
 	"github.com/IBM-Cloud/power-go-client/clients/instance"
 	"github.com/IBM-Cloud/power-go-client/power/models"
@@ -33,6 +34,9 @@ func DataSourceIBMPIPVMSnapshot() *schema.Resource {
 				Type:         schema.TypeString,
 				ValidateFunc: validation.NoZeroValues,
 			},
+			Arg_NameRegex: snapshotFilterSchema()[Arg_NameRegex],
+			Arg_OlderThan: snapshotFilterSchema()[Arg_OlderThan],
+			Arg_Status:    snapshotFilterSchema()[Arg_Status],
 
 			// Attributes
 			Attr_PVMSnapshots: {
@@ -95,7 +99,7 @@ func DataSourceIBMPIPVMSnapshot() *schema.Resource {
 func dataSourceIBMPISnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
@@ -104,12 +108,17 @@ func dataSourceIBMPISnapshotRead(ctx context.Context, d *schema.ResourceData, me
 	snapshotData, err := snapshot.GetSnapShotVM(powerinstancename)
 
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
+	}
+
+	snapshots, err := filterSnapshots(d, snapshotData.Snapshots, time.Now())
+	if err != nil {
+		return piDiagFromErr(err)
 	}
 
 	var clientgenU, _ = uuid.GenerateUUID()
 	d.SetId(clientgenU)
-	d.Set(Attr_PVMSnapshots, flattenPVMSnapshotInstances(snapshotData.Snapshots))
+	d.Set(Attr_PVMSnapshots, flattenPVMSnapshotInstances(snapshots))
 
 	return nil
 }
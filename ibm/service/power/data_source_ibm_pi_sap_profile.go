@@ -43,16 +43,43 @@ func DataSourceIBMPISAPProfile() *schema.Resource {
 				Description: "Amount of cores.",
 				Type:        schema.TypeInt,
 			},
+			Attr_FullSystemProfile: {
+				Computed:    true,
+				Description: "Requires full system for deployment.",
+				Type:        schema.TypeBool,
+			},
 			Attr_Memory: {
 				Computed:    true,
 				Description: "Amount of memory (in GB).",
 				Type:        schema.TypeInt,
 			},
+			Attr_Saps: {
+				Computed:    true,
+				Description: "SAP Application Performance Standard.",
+				Type:        schema.TypeInt,
+			},
+			Attr_SMTMode: {
+				Computed:    true,
+				Description: "Required SMT mode for the profile.",
+				Type:        schema.TypeInt,
+			},
+			Attr_SupportedSystems: {
+				Computed:    true,
+				Description: "List of supported systems.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Type:        schema.TypeList,
+			},
 			Attr_Type: {
 				Computed:    true,
 				Description: "Type of profile.",
 				Type:        schema.TypeString,
 			},
+			Attr_WorkloadTypes: {
+				Computed:    true,
+				Description: "List of certified workload types.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Type:        schema.TypeList,
+			},
 		},
 	}
 }
@@ -60,7 +87,7 @@ func DataSourceIBMPISAPProfile() *schema.Resource {
 func dataSourceIBMPISAPProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
@@ -70,14 +97,19 @@ func dataSourceIBMPISAPProfileRead(ctx context.Context, d *schema.ResourceData,
 	sapProfile, err := client.GetSAPProfile(profileID)
 	if err != nil {
 		log.Printf("[DEBUG] get sap profile failed %v", err)
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	d.SetId(*sapProfile.ProfileID)
 	d.Set(Attr_Certified, *sapProfile.Certified)
 	d.Set(Attr_Cores, *sapProfile.Cores)
+	d.Set(Attr_FullSystemProfile, sapProfile.FullSystemProfile)
 	d.Set(Attr_Memory, *sapProfile.Memory)
+	d.Set(Attr_Saps, sapProfile.Saps)
+	d.Set(Attr_SMTMode, sapProfile.SmtMode)
+	d.Set(Attr_SupportedSystems, sapProfile.SupportedSystems)
 	d.Set(Attr_Type, *sapProfile.Type)
+	d.Set(Attr_WorkloadTypes, sapProfile.WorkloadTypes)
 
 	return nil
 }
@@ -6,8 +6,10 @@ package power
 import (
 	"context"
 	"log"
+	"slices"
 
 	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -26,6 +28,30 @@ func DataSourceIBMPISAPProfiles() *schema.Resource {
 				Type:         schema.TypeString,
 				ValidateFunc: validation.NoZeroValues,
 			},
+			Arg_Family: {
+				Description:  "The profile family to filter the profiles by.",
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice(sapProfileFamilies, false),
+			},
+			Arg_MinCores: {
+				Description:  "The minimum amount of cores of the profiles.",
+				Optional:     true,
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			Arg_MinMemory: {
+				Description:  "The minimum amount of memory (in GB) of the profiles.",
+				Optional:     true,
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			Arg_WorkloadType: {
+				Description:  "The workload type the profiles must be certified for.",
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
 
 			// Attributes
 			Attr_Profiles: {
@@ -43,6 +69,11 @@ func DataSourceIBMPISAPProfiles() *schema.Resource {
 							Description: "Amount of cores.",
 							Type:        schema.TypeInt,
 						},
+						Attr_FullSystemProfile: {
+							Computed:    true,
+							Description: "Requires full system for deployment.",
+							Type:        schema.TypeBool,
+						},
 						Attr_Memory: {
 							Computed:    true,
 							Description: "Amount of memory (in GB).",
@@ -53,11 +84,33 @@ func DataSourceIBMPISAPProfiles() *schema.Resource {
 							Description: "SAP Profile ID.",
 							Type:        schema.TypeString,
 						},
+						Attr_Saps: {
+							Computed:    true,
+							Description: "SAP Application Performance Standard.",
+							Type:        schema.TypeInt,
+						},
+						Attr_SMTMode: {
+							Computed:    true,
+							Description: "Required SMT mode for the profile.",
+							Type:        schema.TypeInt,
+						},
+						Attr_SupportedSystems: {
+							Computed:    true,
+							Description: "List of supported systems.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Type:        schema.TypeList,
+						},
 						Attr_Type: {
 							Computed:    true,
 							Description: "Type of profile.",
 							Type:        schema.TypeString,
 						},
+						Attr_WorkloadTypes: {
+							Computed:    true,
+							Description: "List of certified workload types.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Type:        schema.TypeList,
+						},
 					},
 				},
 				Type: schema.TypeList,
@@ -69,7 +122,7 @@ func DataSourceIBMPISAPProfiles() *schema.Resource {
 func dataSourceIBMPISAPProfilesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
@@ -78,24 +131,67 @@ func dataSourceIBMPISAPProfilesRead(ctx context.Context, d *schema.ResourceData,
 	sapProfiles, err := client.GetAllSAPProfiles(cloudInstanceID)
 	if err != nil {
 		log.Printf("[DEBUG] get all sap profiles failed %v", err)
-		return diag.FromErr(err)
-	}
-
-	result := make([]map[string]interface{}, 0, len(sapProfiles.Profiles))
-	for _, sapProfile := range sapProfiles.Profiles {
-		profile := map[string]interface{}{
-			Attr_Certified: *sapProfile.Certified,
-			Attr_Cores:     *sapProfile.Cores,
-			Attr_Memory:    *sapProfile.Memory,
-			Attr_ProfileID: *sapProfile.ProfileID,
-			Attr_Type:      *sapProfile.Type,
-		}
-		result = append(result, profile)
+		return piDiagFromErr(err)
 	}
 
 	var genID, _ = uuid.GenerateUUID()
 	d.SetId(genID)
-	d.Set(Attr_Profiles, result)
+	d.Set(Attr_Profiles, flattenSAPProfiles(filterSAPProfiles(d, sapProfiles.Profiles)))
 
 	return nil
 }
+
+// sapProfileFamilies are the profile types of the SAP profiles.
+var sapProfileFamilies = []string{
+	models.SAPProfileTypeBalanced,
+	models.SAPProfileTypeCompute,
+	models.SAPProfileTypeMemory,
+	models.SAPProfileTypeNonDashProduction,
+	models.SAPProfileTypeUltraDashMemory,
+	models.SAPProfileTypeSmall,
+	models.SAPProfileTypeSAPRiseOptimized,
+}
+
+// filterSAPProfiles returns the profiles that match the family, minimum cores and memory and
+// workload type arguments.
+func filterSAPProfiles(d *schema.ResourceData, sapProfiles []*models.SAPProfile) []*models.SAPProfile {
+	family := d.Get(Arg_Family).(string)
+	minCores := int64(d.Get(Arg_MinCores).(int))
+	minMemory := int64(d.Get(Arg_MinMemory).(int))
+	workloadType := d.Get(Arg_WorkloadType).(string)
+
+	result := make([]*models.SAPProfile, 0, len(sapProfiles))
+	for _, sapProfile := range sapProfiles {
+		if family != "" && *sapProfile.Type != family {
+			continue
+		}
+		if *sapProfile.Cores < minCores || *sapProfile.Memory < minMemory {
+			continue
+		}
+		if workloadType != "" && !slices.Contains(sapProfile.WorkloadTypes, workloadType) {
+			continue
+		}
+		result = append(result, sapProfile)
+	}
+	return result
+}
+
+func flattenSAPProfiles(sapProfiles []*models.SAPProfile) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(sapProfiles))
+	for _, sapProfile := range sapProfiles {
+		profile := map[string]interface{}{
+			Attr_Certified:         *sapProfile.Certified,
+			Attr_Cores:             *sapProfile.Cores,
+			Attr_FullSystemProfile: sapProfile.FullSystemProfile,
+			Attr_Memory:            *sapProfile.Memory,
+			Attr_ProfileID:         *sapProfile.ProfileID,
+			Attr_Saps:              sapProfile.Saps,
+			Attr_SMTMode:           sapProfile.SmtMode,
+			Attr_SupportedSystems:  sapProfile.SupportedSystems,
+			Attr_Type:              *sapProfile.Type,
+			Attr_WorkloadTypes:     sapProfile.WorkloadTypes,
+		}
+		result = append(result, profile)
+	}
+	return result
+}
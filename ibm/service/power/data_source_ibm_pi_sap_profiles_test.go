@@ -27,9 +27,35 @@ func TestAccIBMPISAPProfilesDataSourceBasic(t *testing.T) {
 	})
 }
 
+func TestAccIBMPISAPProfilesDataSourceFilter(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPISAPProfilesDataSourceFilterConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_pi_sap_profiles.test", "id"),
+					resource.TestCheckResourceAttr("data.ibm_pi_sap_profiles.test", "profiles.0.type", "balanced"),
+					resource.TestCheckResourceAttrSet("data.ibm_pi_sap_profiles.test", "profiles.0.workload_types.#"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMPISAPProfilesDataSourceConfig() string {
 	return fmt.Sprintf(`
 		data "ibm_pi_sap_profiles" "test" {
 			pi_cloud_instance_id = "%s"
 		}`, acc.Pi_cloud_instance_id)
 }
+
+func testAccCheckIBMPISAPProfilesDataSourceFilterConfig() string {
+	return fmt.Sprintf(`
+		data "ibm_pi_sap_profiles" "test" {
+			pi_cloud_instance_id = "%s"
+			pi_family            = "balanced"
+			pi_min_memory        = 256
+		}`, acc.Pi_cloud_instance_id)
+}
@@ -0,0 +1,61 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testPISAPProfile(id, family string, cores, memory int64, workloadTypes ...string) *models.SAPProfile {
+	certified := true
+	return &models.SAPProfile{
+		Certified:        &certified,
+		Cores:            &cores,
+		Memory:           &memory,
+		ProfileID:        &id,
+		SupportedSystems: []string{"e980", "e1080"},
+		Type:             &family,
+		WorkloadTypes:    workloadTypes,
+	}
+}
+
+func TestFilterSAPProfiles(t *testing.T) {
+	profiles := []*models.SAPProfile{
+		testPISAPProfile("bh1-140x7000", models.SAPProfileTypeBalanced, 140, 7000, "HANA", "NetWeaver"),
+		testPISAPProfile("bh1-22x1100", models.SAPProfileTypeBalanced, 22, 1100, "NetWeaver"),
+		testPISAPProfile("ch1-60x3000", models.SAPProfileTypeCompute, 60, 3000, "HANA"),
+		testPISAPProfile("mh1-8x1440", models.SAPProfileTypeMemory, 8, 1440, "HANA"),
+	}
+
+	tests := []struct {
+		name string
+		args map[string]interface{}
+		want string
+	}{
+		{name: "no filter", args: map[string]interface{}{}, want: "bh1-140x7000,bh1-22x1100,ch1-60x3000,mh1-8x1440"},
+		{name: "family", args: map[string]interface{}{Arg_Family: models.SAPProfileTypeBalanced}, want: "bh1-140x7000,bh1-22x1100"},
+		{name: "minimum cores", args: map[string]interface{}{Arg_MinCores: 60}, want: "bh1-140x7000,ch1-60x3000"},
+		{name: "minimum memory", args: map[string]interface{}{Arg_MinMemory: 1200}, want: "bh1-140x7000,ch1-60x3000,mh1-8x1440"},
+		{name: "workload type", args: map[string]interface{}{Arg_WorkloadType: "HANA", Arg_MinMemory: 3000}, want: "bh1-140x7000,ch1-60x3000"},
+		{name: "no match", args: map[string]interface{}{Arg_Family: models.SAPProfileTypeSmall}, want: ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.args[Arg_CloudInstanceID] = "workspace"
+			d := schema.TestResourceDataRaw(t, DataSourceIBMPISAPProfiles().Schema, tc.args)
+
+			var got []string
+			for _, profile := range filterSAPProfiles(d, profiles) {
+				got = append(got, *profile.ProfileID)
+			}
+			if strings.Join(got, ",") != tc.want {
+				t.Errorf("profiles = %s, want %s", strings.Join(got, ","), tc.want)
+			}
+		})
+	}
+}
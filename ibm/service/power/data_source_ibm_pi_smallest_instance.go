@@ -0,0 +1,131 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Smallest sizes accepted by the instance create API.
+const (
+	minInstanceDedicatedProcessors = 1
+	minInstanceMemory              = 2
+	minInstanceSharedProcessors    = 0.25
+)
+
+func DataSourceIBMPISmallestInstance() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMPISmallestInstanceRead,
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_CloudInstanceID: {
+				Description:  "The GUID of the service instance associated with an account.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_ProcType: {
+				Default:      "shared",
+				Description:  "The processor type of the instance.",
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"dedicated", "shared", "capped"}),
+			},
+
+			// Attributes
+			Attr_Memory: {
+				Computed:    true,
+				Description: "The smallest amount of memory (GB) an instance can be created with.",
+				Type:        schema.TypeFloat,
+			},
+			Attr_Processors: {
+				Computed:    true,
+				Description: "The smallest number of processors an instance of the processor type can be created with.",
+				Type:        schema.TypeFloat,
+			},
+			Attr_ProcType: {
+				Computed:    true,
+				Description: "The processor type of the instance.",
+				Type:        schema.TypeString,
+			},
+			Attr_SysType: {
+				Computed:    true,
+				Description: "The least expensive system type of the workspace with a host that can currently fit the instance.",
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func dataSourceIBMPISmallestInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	procType := d.Get(Arg_ProcType).(string)
+
+	client := instance.NewIBMPISystemPoolClient(ctx, sess, cloudInstanceID)
+	sps, err := client.GetSystemPools()
+	if err != nil {
+		log.Printf("[ERROR] get system pools capacity failed %v", err)
+		return piDiagFromErr(err)
+	}
+
+	processors := float64(minInstanceSharedProcessors)
+	if procType == "dedicated" {
+		processors = minInstanceDedicatedProcessors
+	}
+
+	sysTypes := make([]string, 0, len(sps))
+	for sysType := range sps {
+		sysTypes = append(sysTypes, sysType)
+	}
+	sortSysTypesByCost(sysTypes)
+
+	sysType := ""
+	for _, st := range sysTypes {
+		if _, _, limitingDimension := checkSystemPoolCapacity(sps[st], processors, minInstanceMemory); limitingDimension == "" {
+			sysType = st
+			break
+		}
+	}
+	if sysType == "" {
+		return piDiagFromErr(fmt.Errorf("no system pool of workspace %s can currently host an instance with %v %s processors and %d GB of memory", cloudInstanceID, processors, procType, minInstanceMemory))
+	}
+
+	var genID, _ = uuid.GenerateUUID()
+	d.SetId(genID)
+	d.Set(Attr_Memory, minInstanceMemory)
+	d.Set(Attr_Processors, processors)
+	d.Set(Attr_ProcType, procType)
+	d.Set(Attr_SysType, sysType)
+
+	return nil
+}
+
+// sortSysTypesByCost orders system types from least to most expensive: scale-out systems (s922,
+// s1022, ...) before enterprise systems (e980, e1080, ...), and by name within each class.
+func sortSysTypesByCost(sysTypes []string) {
+	sort.Slice(sysTypes, func(i, j int) bool {
+		si, sj := strings.HasPrefix(sysTypes[i], "s"), strings.HasPrefix(sysTypes[j], "s")
+		if si != sj {
+			return si
+		}
+		return sysTypes[i] < sysTypes[j]
+	})
+}
@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMPISmallestInstanceDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPISmallestInstanceDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_pi_smallest_instance.smallest", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_pi_smallest_instance.smallest", "sys_type"),
+					resource.TestCheckResourceAttr("data.ibm_pi_smallest_instance.smallest", "proctype", "shared"),
+					resource.TestCheckResourceAttr("data.ibm_pi_smallest_instance.smallest", "processors", "0.25"),
+					resource.TestCheckResourceAttr("data.ibm_pi_smallest_instance.smallest", "memory", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPISmallestInstanceDataSourceConfig() string {
+	return fmt.Sprintf(`
+		data "ibm_pi_smallest_instance" "smallest" {
+			pi_cloud_instance_id = "%s"
+		}`, acc.Pi_cloud_instance_id)
+}
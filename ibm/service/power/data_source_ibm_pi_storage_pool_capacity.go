@@ -34,6 +34,11 @@ func DataSourceIBMPIStoragePoolCapacity() *schema.Resource {
 			},
 
 			// Attributes
+			Attr_AvailableCapacity: {
+				Computed:    true,
+				Description: "Available pool capacity (GB).",
+				Type:        schema.TypeInt,
+			},
 			Attr_MaxAllocationSize: {
 				Computed:    true,
 				Description: "Maximum allocation storage size (GB).",
@@ -44,6 +49,11 @@ func DataSourceIBMPIStoragePoolCapacity() *schema.Resource {
 				Description: "Replication status of the storage pool.",
 				Type:        schema.TypeBool,
 			},
+			Attr_StorageHost: {
+				Computed:    true,
+				Description: "The storage host name of the storage pool.",
+				Type:        schema.TypeString,
+			},
 			Attr_StorageType: {
 				Computed:    true,
 				Description: "Storage type of the storage pool.",
@@ -61,7 +71,7 @@ func DataSourceIBMPIStoragePoolCapacity() *schema.Resource {
 func dataSourceIBMPIStoragePoolCapacityRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
@@ -71,12 +81,16 @@ func dataSourceIBMPIStoragePoolCapacityRead(ctx context.Context, d *schema.Resou
 	sp, err := client.GetStoragePoolCapacity(storagePool)
 	if err != nil {
 		log.Printf("[ERROR] get storage pool capacity failed %v", err)
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, storagePool))
+	d.Set(Attr_AvailableCapacity, sp.AvailableCapacity)
 	d.Set(Attr_MaxAllocationSize, *sp.MaxAllocationSize)
-	d.Set(Attr_ReplicationEnabled, *sp.ReplicationEnabled)
+	if sp.ReplicationEnabled != nil {
+		d.Set(Attr_ReplicationEnabled, *sp.ReplicationEnabled)
+	}
+	d.Set(Attr_StorageHost, sp.StorageHost)
 	d.Set(Attr_StorageType, sp.StorageType)
 	d.Set(Attr_TotalCapacity, sp.TotalCapacity)
 	return nil
@@ -21,6 +21,7 @@ func TestAccIBMPIStoragePoolCapacityDataSourceBasic(t *testing.T) {
 				Config: testAccCheckIBMPIStoragePoolCapacityDataSourceConfig(),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.ibm_pi_storage_pool_capacity.pool", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_pi_storage_pool_capacity.pool", "available_capacity"),
 				),
 			},
 		},
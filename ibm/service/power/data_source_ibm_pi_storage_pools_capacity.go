@@ -8,6 +8,7 @@ import (
 	"log"
 
 	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/go-uuid"
@@ -39,6 +40,11 @@ func DataSourceIBMPIStoragePoolsCapacity() *schema.Resource {
 				Description: "List of storage pools capacity.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						Attr_AvailableCapacity: {
+							Computed:    true,
+							Description: "Available pool capacity (GB).",
+							Type:        schema.TypeInt,
+						},
 						Attr_MaxAllocationSize: {
 							Computed:    true,
 							Description: "Maximum allocation storage size (GB).",
@@ -54,6 +60,11 @@ func DataSourceIBMPIStoragePoolsCapacity() *schema.Resource {
 							Description: "Replication status of the storage pool.",
 							Type:        schema.TypeBool,
 						},
+						Attr_StorageHost: {
+							Computed:    true,
+							Description: "The storage host name of the storage pool.",
+							Type:        schema.TypeString,
+						},
 						Attr_StorageType: {
 							Computed:    true,
 							Description: "Storage type of the storage pool.",
@@ -75,7 +86,7 @@ func DataSourceIBMPIStoragePoolsCapacity() *schema.Resource {
 func dataSourceIBMPIStoragePoolsCapacityRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
@@ -84,7 +95,7 @@ func dataSourceIBMPIStoragePoolsCapacityRead(ctx context.Context, d *schema.Reso
 	spc, err := client.GetAllStoragePoolsCapacity()
 	if err != nil {
 		log.Printf("[ERROR] get all storage pools capacity failed %v", err)
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	var genID, _ = uuid.GenerateUUID()
@@ -100,18 +111,26 @@ func dataSourceIBMPIStoragePoolsCapacityRead(ctx context.Context, d *schema.Reso
 		d.Set(Attr_MaximumStorageAllocation, flex.Flatten(data))
 	}
 
-	result := make([]map[string]interface{}, 0, len(spc.StoragePoolsCapacity))
-	for _, sp := range spc.StoragePoolsCapacity {
+	d.Set(Attr_StoragePoolsCapacity, flattenIBMPIStoragePoolsCapacity(spc.StoragePoolsCapacity))
+
+	return nil
+}
+
+func flattenIBMPIStoragePoolsCapacity(pools []*models.StoragePoolCapacity) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(pools))
+	for _, sp := range pools {
 		data := map[string]interface{}{
-			Attr_MaxAllocationSize:  *sp.MaxAllocationSize,
-			Attr_PoolName:           sp.PoolName,
-			Attr_ReplicationEnabled: *sp.ReplicationEnabled,
-			Attr_StorageType:        sp.StorageType,
-			Attr_TotalCapacity:      sp.TotalCapacity,
+			Attr_AvailableCapacity: sp.AvailableCapacity,
+			Attr_MaxAllocationSize: *sp.MaxAllocationSize,
+			Attr_PoolName:          sp.PoolName,
+			Attr_StorageHost:       sp.StorageHost,
+			Attr_StorageType:       sp.StorageType,
+			Attr_TotalCapacity:     sp.TotalCapacity,
+		}
+		if sp.ReplicationEnabled != nil {
+			data[Attr_ReplicationEnabled] = *sp.ReplicationEnabled
 		}
 		result = append(result, data)
 	}
-	d.Set(Attr_StoragePoolsCapacity, result)
-
-	return nil
+	return result
 }
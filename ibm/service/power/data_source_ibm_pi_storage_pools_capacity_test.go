@@ -21,6 +21,7 @@ func TestAccIBMPIStoragePoolsCapacityDataSourceBasic(t *testing.T) {
 				Config: testAccCheckIBMPIStoragePoolsCapacityDataSourceConfig(),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.ibm_pi_storage_pools_capacity.pools", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_pi_storage_pools_capacity.pools", "storage_pools_capacity.0.available_capacity"),
 				),
 			},
 		},
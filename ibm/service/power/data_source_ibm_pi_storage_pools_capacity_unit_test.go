@@ -0,0 +1,32 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"testing"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+)
+
+func TestFlattenIBMPIStoragePoolsCapacity(t *testing.T) {
+	maxAllocation, replicated := int64(2048), true
+	pools := []*models.StoragePoolCapacity{
+		{AvailableCapacity: 4096, MaxAllocationSize: &maxAllocation, PoolName: "Tier1-Flash-1", ReplicationEnabled: &replicated, StorageHost: "host-1", StorageType: "tier1", TotalCapacity: 8192},
+		{AvailableCapacity: 512, MaxAllocationSize: &maxAllocation, PoolName: "Tier3-Flash-1", StorageType: "tier3", TotalCapacity: 1024},
+	}
+
+	result := flattenIBMPIStoragePoolsCapacity(pools)
+	if len(result) != 2 {
+		t.Fatalf("got %d pools, want 2", len(result))
+	}
+	if got := result[0][Attr_AvailableCapacity]; got != int64(4096) {
+		t.Errorf("available capacity = %v, want 4096", got)
+	}
+	if got := result[0][Attr_ReplicationEnabled]; got != true {
+		t.Errorf("replication enabled = %v, want true", got)
+	}
+	if _, ok := result[1][Attr_ReplicationEnabled]; ok {
+		t.Error("replication enabled was set for a pool that does not report it")
+	}
+}
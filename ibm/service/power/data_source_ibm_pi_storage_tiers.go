@@ -0,0 +1,122 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/IBM-Cloud/power-go-client/helpers"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_storage_tiers"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceIBMPIStorageTiers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMPIStorageTiersRead,
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_CloudInstanceID: {
+				Description:  "The GUID of the service instance associated with an account.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// Attributes
+			Attr_ActiveTiers: {
+				Computed:    true,
+				Description: "The names of the storage tiers that are active for the workspace.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Type:        schema.TypeList,
+			},
+			Attr_RegionStorageTiers: {
+				Computed:    true,
+				Description: "The storage tiers of the region of the workspace, and whether they are active.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_Description: {
+							Computed:    true,
+							Description: "The label of the storage tier.",
+							Type:        schema.TypeString,
+						},
+						Attr_Name: {
+							Computed:    true,
+							Description: "The name of the storage tier.",
+							Type:        schema.TypeString,
+						},
+						Attr_State: {
+							Computed:    true,
+							Description: "The state of the storage tier, active or inactive.",
+							Type:        schema.TypeString,
+						},
+					},
+				},
+				Type: schema.TypeList,
+			},
+		},
+	}
+}
+
+func dataSourceIBMPIStorageTiersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+
+	// The instance clients of the SDK have no storage tiers client, so the generated client is used
+	params := p_cloud_storage_tiers.NewPcloudCloudinstancesStoragetiersGetallParams().
+		WithContext(ctx).WithTimeout(helpers.PIGetTimeOut).
+		WithCloudInstanceID(cloudInstanceID)
+	resp, err := sess.Power.PCloudStorageTiers.PcloudCloudinstancesStoragetiersGetall(params, sess.AuthInfo(cloudInstanceID))
+	if err != nil {
+		log.Printf("[ERROR] get all storage tiers failed %v", err)
+		return piDiagFromErr(fmt.Errorf("failed to get the storage tiers of workspace %s: %w", cloudInstanceID, err))
+	}
+
+	var genID, _ = uuid.GenerateUUID()
+	d.SetId(genID)
+	tiers, active := flattenIBMPIStorageTiers(resp.Payload)
+	d.Set(Attr_ActiveTiers, active)
+	d.Set(Attr_RegionStorageTiers, tiers)
+
+	return nil
+}
+
+// flattenIBMPIStorageTiers returns the storage tiers sorted by name, and the names of the active ones.
+func flattenIBMPIStorageTiers(list models.RegionStorageTiers) ([]map[string]interface{}, []string) {
+	tiers := make([]map[string]interface{}, 0, len(list))
+	active := []string{}
+	for _, tier := range list {
+		if tier == nil {
+			continue
+		}
+		state := ""
+		if tier.State != nil {
+			state = *tier.State
+		}
+		tiers = append(tiers, map[string]interface{}{
+			Attr_Description: tier.Description,
+			Attr_Name:        tier.Name,
+			Attr_State:       state,
+		})
+		if state == models.StorageTierStateActive {
+			active = append(active, tier.Name)
+		}
+	}
+	sort.Slice(tiers, func(i, j int) bool {
+		return tiers[i][Attr_Name].(string) < tiers[j][Attr_Name].(string)
+	})
+	sort.Strings(active)
+	return tiers, active
+}
@@ -0,0 +1,45 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/power/internal/powertest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataSourceIBMPIStorageTiersRead(t *testing.T) {
+	server := powertest.NewServer(t)
+	active, inactive := models.StorageTierStateActive, models.StorageTierStateInactive
+	server.AddStorageTier(&models.StorageTier{Name: "tier5k", Description: "Fixed IOPs", State: &inactive})
+	server.AddStorageTier(&models.StorageTier{Name: "tier3", Description: "Tier 3", State: &active})
+	server.AddStorageTier(&models.StorageTier{Name: "tier0", Description: "Tier 0", State: &active})
+	server.AddStorageTier(nil)
+
+	d := schema.TestResourceDataRaw(t, DataSourceIBMPIStorageTiers().Schema, map[string]interface{}{
+		Arg_CloudInstanceID: powertest.CloudInstanceID,
+	})
+	if diags := dataSourceIBMPIStorageTiersRead(context.Background(), d, server.Meta(t)); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, want := d.Get(Attr_ActiveTiers).([]interface{}), []interface{}{"tier0", "tier3"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("%s = %v, want %v", Attr_ActiveTiers, got, want)
+	}
+	var names, states []string
+	for _, tier := range d.Get(Attr_RegionStorageTiers).([]interface{}) {
+		names = append(names, tier.(map[string]interface{})[Attr_Name].(string))
+		states = append(states, tier.(map[string]interface{})[Attr_State].(string))
+	}
+	if want := []string{"tier0", "tier3", "tier5k"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("tier names = %v, want %v", names, want)
+	}
+	if want := []string{"active", "active", "inactive"}; !reflect.DeepEqual(states, want) {
+		t.Errorf("tier states = %v, want %v", states, want)
+	}
+}
@@ -45,6 +45,11 @@ func DataSourceIBMPIStorageTypeCapacity() *schema.Resource {
 				Description: "List of storage pools capacity.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						Attr_AvailableCapacity: {
+							Computed:    true,
+							Description: "Available pool capacity (GB).",
+							Type:        schema.TypeInt,
+						},
 						Attr_MaxAllocationSize: {
 							Computed:    true,
 							Description: "Maximum allocation storage size (GB).",
@@ -55,6 +60,16 @@ func DataSourceIBMPIStorageTypeCapacity() *schema.Resource {
 							Description: "The pool name",
 							Type:        schema.TypeString,
 						},
+						Attr_ReplicationEnabled: {
+							Computed:    true,
+							Description: "Replication status of the storage pool.",
+							Type:        schema.TypeBool,
+						},
+						Attr_StorageHost: {
+							Computed:    true,
+							Description: "The storage host name of the storage pool.",
+							Type:        schema.TypeString,
+						},
 						Attr_StorageType: {
 							Computed:    true,
 							Description: "Storage type of the storage pool.",
@@ -76,7 +91,7 @@ func DataSourceIBMPIStorageTypeCapacity() *schema.Resource {
 func dataSourceIBMPIStorageTypeCapacityRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
@@ -86,7 +101,7 @@ func dataSourceIBMPIStorageTypeCapacityRead(ctx context.Context, d *schema.Resou
 	stc, err := client.GetStorageTypeCapacity(storageType)
 	if err != nil {
 		log.Printf("[ERROR] get storage type capacity failed %v", err)
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, storageType))
@@ -101,17 +116,7 @@ func dataSourceIBMPIStorageTypeCapacityRead(ctx context.Context, d *schema.Resou
 		d.Set(Attr_MaximumStorageAllocation, flex.Flatten(data))
 	}
 
-	result := make([]map[string]interface{}, 0, len(stc.StoragePoolsCapacity))
-	for _, sp := range stc.StoragePoolsCapacity {
-		data := map[string]interface{}{
-			Attr_MaxAllocationSize: *sp.MaxAllocationSize,
-			Attr_PoolName:          sp.PoolName,
-			Attr_StorageType:       sp.StorageType,
-			Attr_TotalCapacity:     sp.TotalCapacity,
-		}
-		result = append(result, data)
-	}
-	d.Set(Attr_StoragePoolsCapacity, result)
+	d.Set(Attr_StoragePoolsCapacity, flattenIBMPIStoragePoolsCapacity(stc.StoragePoolsCapacity))
 
 	return nil
 }
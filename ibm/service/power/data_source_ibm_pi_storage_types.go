@@ -0,0 +1,152 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"log"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// storageTierIOPS holds the documented performance of the Power Systems Virtual Server storage
+// tiers: IOPS per GB for the scaling tiers, or a fixed IOPS value for tier5k.
+var storageTierIOPS = map[string]struct {
+	iopsPerGB float64
+	fixedIOPS int
+}{
+	"tier0":  {iopsPerGB: 25},
+	"tier1":  {iopsPerGB: 10},
+	"tier3":  {iopsPerGB: 3},
+	"tier5k": {fixedIOPS: 5000},
+}
+
+func DataSourceIBMPIStorageTypes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMPIStorageTypesRead,
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_CloudInstanceID: {
+				Description:  "The GUID of the service instance associated with an account.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// Attributes
+			Attr_StorageTypes: {
+				Computed:    true,
+				Description: "List of storage types available in the datacenter of the workspace.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_FixedIOPS: {
+							Computed:    true,
+							Description: "The fixed IOPS of volumes of the storage type, regardless of size; 0 when the IOPS scale with the volume size.",
+							Type:        schema.TypeInt,
+						},
+						Attr_IOPSPerGB: {
+							Computed:    true,
+							Description: "The IOPS per GB of volumes of the storage type; 0 when the storage type has fixed IOPS or is not known to the provider.",
+							Type:        schema.TypeFloat,
+						},
+						Attr_MaxAllocationSize: {
+							Computed:    true,
+							Description: "Maximum allocation storage size (GB) of the storage type.",
+							Type:        schema.TypeInt,
+						},
+						Attr_PoolNames: {
+							Computed:    true,
+							Description: "The names of the storage pools of the storage type.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Type:        schema.TypeList,
+						},
+						Attr_ReplicationEnabled: {
+							Computed:    true,
+							Description: "Indicates if a storage pool of the storage type is replication enabled.",
+							Type:        schema.TypeBool,
+						},
+						Attr_Type: {
+							Computed:    true,
+							Description: "The storage type.",
+							Type:        schema.TypeString,
+						},
+					},
+				},
+				Type: schema.TypeList,
+			},
+			Attr_Types: {
+				Computed:    true,
+				Description: "The names of the storage types available in the datacenter of the workspace.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Type:        schema.TypeList,
+			},
+		},
+	}
+}
+
+func dataSourceIBMPIStorageTypesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+
+	client := instance.NewIBMPIStorageCapacityClient(ctx, sess, cloudInstanceID)
+	stc, err := client.GetAllStorageTypesCapacity()
+	if err != nil {
+		log.Printf("[ERROR] get all storage types capacity failed %v", err)
+		return piDiagFromErr(err)
+	}
+
+	var genID, _ = uuid.GenerateUUID()
+	d.SetId(genID)
+
+	storageTypes := make([]map[string]interface{}, 0, len(stc.StorageTypesCapacity))
+	types := make([]string, 0, len(stc.StorageTypesCapacity))
+	for _, st := range stc.StorageTypesCapacity {
+		if st == nil {
+			continue
+		}
+		storageTypes = append(storageTypes, flattenStorageType(st))
+		types = append(types, st.StorageType)
+	}
+	d.Set(Attr_StorageTypes, storageTypes)
+	d.Set(Attr_Types, types)
+
+	return nil
+}
+
+func flattenStorageType(st *models.StorageTypeCapacity) map[string]interface{} {
+	var maxAllocationSize int64
+	if st.MaximumStorageAllocation != nil && st.MaximumStorageAllocation.MaxAllocationSize != nil {
+		maxAllocationSize = *st.MaximumStorageAllocation.MaxAllocationSize
+	}
+	poolNames := make([]string, 0, len(st.StoragePoolsCapacity))
+	replicationEnabled := false
+	for _, sp := range st.StoragePoolsCapacity {
+		if sp == nil {
+			continue
+		}
+		poolNames = append(poolNames, sp.PoolName)
+		if sp.ReplicationEnabled != nil && *sp.ReplicationEnabled {
+			replicationEnabled = true
+		}
+	}
+	iops := storageTierIOPS[st.StorageType]
+	return map[string]interface{}{
+		Attr_FixedIOPS:          iops.fixedIOPS,
+		Attr_IOPSPerGB:          iops.iopsPerGB,
+		Attr_MaxAllocationSize:  maxAllocationSize,
+		Attr_PoolNames:          poolNames,
+		Attr_ReplicationEnabled: replicationEnabled,
+		Attr_Type:               st.StorageType,
+	}
+}
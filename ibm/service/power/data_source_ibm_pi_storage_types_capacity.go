@@ -50,6 +50,11 @@ func DataSourceIBMPIStorageTypesCapacity() *schema.Resource {
 							Description: "List of storage types capacity.",
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									Attr_AvailableCapacity: {
+										Computed:    true,
+										Description: "Available pool capacity (GB).",
+										Type:        schema.TypeInt,
+									},
 									Attr_MaxAllocationSize: {
 										Computed:    true,
 										Description: "Maximum allocation storage size (GB).",
@@ -60,6 +65,16 @@ func DataSourceIBMPIStorageTypesCapacity() *schema.Resource {
 										Description: "The pool name.",
 										Type:        schema.TypeString,
 									},
+									Attr_ReplicationEnabled: {
+										Computed:    true,
+										Description: "Replication status of the storage pool.",
+										Type:        schema.TypeBool,
+									},
+									Attr_StorageHost: {
+										Computed:    true,
+										Description: "The storage host name of the storage pool.",
+										Type:        schema.TypeString,
+									},
 									Attr_StorageType: {
 										Computed:    true,
 										Description: "Storage type of the storage pool.",
@@ -89,7 +104,7 @@ func DataSourceIBMPIStorageTypesCapacity() *schema.Resource {
 func dataSourceIBMPIStorageTypesCapacityRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
@@ -98,7 +113,7 @@ func dataSourceIBMPIStorageTypesCapacityRead(ctx context.Context, d *schema.Reso
 	stc, err := client.GetAllStorageTypesCapacity()
 	if err != nil {
 		log.Printf("[ERROR] get all storage types capacity failed %v", err)
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	var genID, _ = uuid.GenerateUUID()
@@ -125,17 +140,7 @@ func dataSourceIBMPIStorageTypesCapacityRead(ctx context.Context, d *schema.Reso
 			}
 			stResult[Attr_MaximumStorageAllocation] = flex.Flatten(data)
 		}
-		spc := make([]map[string]interface{}, 0, len(st.StoragePoolsCapacity))
-		for _, sp := range st.StoragePoolsCapacity {
-			data := map[string]interface{}{
-				Attr_MaxAllocationSize: *sp.MaxAllocationSize,
-				Attr_PoolName:          sp.PoolName,
-				Attr_StorageType:       sp.StorageType,
-				Attr_TotalCapacity:     sp.TotalCapacity,
-			}
-			spc = append(spc, data)
-		}
-		stResult[Attr_StoragePoolsCapacity] = spc
+		stResult[Attr_StoragePoolsCapacity] = flattenIBMPIStoragePoolsCapacity(st.StoragePoolsCapacity)
 		stResult[Attr_StorageType] = st.StorageType
 		stcResult = append(stcResult, stResult)
 	}
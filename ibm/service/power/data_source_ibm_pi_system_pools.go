@@ -110,7 +110,7 @@ func DataSourceIBMPISystemPools() *schema.Resource {
 func dataSourceIBMPISystemPoolsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
@@ -119,7 +119,7 @@ func dataSourceIBMPISystemPoolsRead(ctx context.Context, d *schema.ResourceData,
 	sps, err := client.GetSystemPools()
 	if err != nil {
 		log.Printf("[ERROR] get system pools capacity failed %v", err)
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	var genID, _ = uuid.GenerateUUID()
@@ -67,7 +67,7 @@ func DataSourceIBMPITenant() *schema.Resource {
 func dataSourceIBMPITenantRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
@@ -75,7 +75,7 @@ func dataSourceIBMPITenantRead(ctx context.Context, d *schema.ResourceData, meta
 	tenantC := instance.NewIBMPITenantClient(ctx, sess, cloudInstanceID)
 	tenantData, err := tenantC.GetSelfTenant()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	d.SetId(*tenantData.TenantID)
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -30,6 +31,7 @@ func DataSourceIBMPIVolume() *schema.Resource {
 				Type:         schema.TypeString,
 				ValidateFunc: validation.NoZeroValues,
 			},
+			Arg_WaitUntil: waitUntilSchema("The state of the volume to wait for, such as available or in-use."),
 
 			// Attributes
 			Attr_Auxiliary: {
@@ -129,14 +131,22 @@ func DataSourceIBMPIVolume() *schema.Resource {
 func dataSourceIBMPIVolumeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
 	volumeC := instance.NewIBMPIVolumeClient(ctx, sess, cloudInstanceID)
-	volumedata, err := volumeC.Get(d.Get(Arg_VolumeName).(string))
+	var volumedata *models.Volume
+	err = readWithWaitUntil(ctx, d, func() (string, error) {
+		var err error
+		volumedata, err = volumeC.Get(d.Get(Arg_VolumeName).(string))
+		if err != nil {
+			return "", err
+		}
+		return volumedata.State, nil
+	})
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	d.SetId(*volumedata.VolumeID)
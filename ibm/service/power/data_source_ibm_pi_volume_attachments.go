@@ -0,0 +1,130 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceIBMPIVolumeAttachments() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMPIVolumeAttachmentsRead,
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_CloudInstanceID: {
+				Description:  "The GUID of the service instance associated with an account.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_VolumeID: {
+				Description:  "The ID or name of the volume.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// Attributes
+			Attr_Attachments: {
+				Computed:    true,
+				Description: "The instances the volume is attached to.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_PVMInstanceID: {
+							Computed:    true,
+							Description: "The ID of the instance.",
+							Type:        schema.TypeString,
+						},
+						Attr_ServerName: {
+							Computed:    true,
+							Description: "The name of the instance.",
+							Type:        schema.TypeString,
+						},
+						Attr_Status: {
+							Computed:    true,
+							Description: "The status of the instance.",
+							Type:        schema.TypeString,
+						},
+					},
+				},
+				Type: schema.TypeList,
+			},
+			Attr_LastUpdateDate: {
+				Computed:    true,
+				Description: "The date and time the volume was last updated, such as when it was last attached or detached.",
+				Type:        schema.TypeString,
+			},
+			Attr_PVMInstanceIDs: {
+				Computed:    true,
+				Description: "The IDs of the instances the volume is attached to.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Type:        schema.TypeList,
+			},
+			Attr_State: {
+				Computed:    true,
+				Description: "The state of the volume.",
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func dataSourceIBMPIVolumeAttachmentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	volumeClient := instance.NewIBMPIVolumeClient(ctx, sess, cloudInstanceID)
+	vol, err := volumeClient.Get(d.Get(Arg_VolumeID).(string))
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+
+	attachments, err := getIBMPIVolumeAttachments(instance.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID), vol)
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, *vol.VolumeID))
+	d.Set(Attr_Attachments, attachments)
+	if vol.LastUpdateDate != nil {
+		d.Set(Attr_LastUpdateDate, vol.LastUpdateDate.String())
+	}
+	d.Set(Attr_PVMInstanceIDs, vol.PvmInstanceIDs)
+	d.Set(Attr_State, vol.State)
+
+	return nil
+}
+
+// getIBMPIVolumeAttachments returns the name and status of the instances the volume is attached to.
+func getIBMPIVolumeAttachments(client *instance.IBMPIInstanceClient, vol *models.Volume) ([]map[string]interface{}, error) {
+	attachments := make([]map[string]interface{}, 0, len(vol.PvmInstanceIDs))
+	for _, id := range vol.PvmInstanceIDs {
+		pvm, err := client.Get(id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the instance %s the volume %s is attached to: %w", id, *vol.VolumeID, err)
+		}
+		attachment := map[string]interface{}{
+			Attr_PVMInstanceID: id,
+		}
+		if pvm.ServerName != nil {
+			attachment[Attr_ServerName] = *pvm.ServerName
+		}
+		if pvm.Status != nil {
+			attachment[Attr_Status] = *pvm.Status
+		}
+		attachments = append(attachments, attachment)
+	}
+	return attachments, nil
+}
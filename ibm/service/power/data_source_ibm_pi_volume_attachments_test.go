@@ -0,0 +1,38 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMPIVolumeAttachmentsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIVolumeAttachmentsDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_pi_volume_attachments.testacc_ds_volume_attachments", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_pi_volume_attachments.testacc_ds_volume_attachments", "attachments.#"),
+					resource.TestCheckResourceAttrSet("data.ibm_pi_volume_attachments.testacc_ds_volume_attachments", "state"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIVolumeAttachmentsDataSourceConfig() string {
+	return fmt.Sprintf(`
+		data "ibm_pi_volume_attachments" "testacc_ds_volume_attachments" {
+			pi_cloud_instance_id = "%s"
+			pi_volume_id         = "%s"
+		}`, acc.Pi_cloud_instance_id, acc.Pi_volume_name)
+}
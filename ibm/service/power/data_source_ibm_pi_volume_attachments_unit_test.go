@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/power/internal/powertest"
+)
+
+func TestGetIBMPIVolumeAttachments(t *testing.T) {
+	server := powertest.NewServer(t)
+	client := instance.NewIBMPIInstanceClient(context.Background(), server.Session(t), powertest.CloudInstanceID)
+	server.AddInstance(testPIInstance("gpfs-1", "ACTIVE", Health_OK))
+	server.AddInstance(testPIInstance("gpfs-2", StatusShutoff, Health_OK))
+
+	volumeID := "shared"
+	attachments, err := getIBMPIVolumeAttachments(client, &models.Volume{VolumeID: &volumeID, PvmInstanceIDs: []string{"gpfs-1", "gpfs-2"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []map[string]interface{}{
+		{Attr_PVMInstanceID: "gpfs-1", Attr_ServerName: "vm-gpfs-1", Attr_Status: "ACTIVE"},
+		{Attr_PVMInstanceID: "gpfs-2", Attr_ServerName: "vm-gpfs-2", Attr_Status: StatusShutoff},
+	}
+	if !reflect.DeepEqual(attachments, want) {
+		t.Errorf("attachments = %v, want %v", attachments, want)
+	}
+
+	_, err = getIBMPIVolumeAttachments(client, &models.Volume{VolumeID: &volumeID, PvmInstanceIDs: []string{"gpfs-3"}})
+	if err == nil || !strings.Contains(err.Error(), "failed to get the instance gpfs-3 the volume shared is attached to") {
+		t.Errorf("error = %v, want an error for the missing instance", err)
+	}
+}
@@ -84,14 +84,14 @@ func DataSourceIBMPIVolumeFlashCopyMappings() *schema.Resource {
 func dataSourceIBMPIVolumeFlashCopyMappings(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
 	volClient := instance.NewIBMPIVolumeClient(ctx, sess, cloudInstanceID)
 	volData, err := volClient.GetVolumeFlashCopyMappings(d.Get(Arg_VolumeID).(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	results := make([]map[string]interface{}, 0, len(volData))
@@ -37,6 +37,7 @@ func DataSourceIBMPIVolumeGroupDetails() *schema.Resource {
 				Description: "The name of consistency group at storage controller level.",
 				Type:        schema.TypeString,
 			},
+			Attr_RemoteCopyRelationships: remoteCopyRelationshipsSchema(),
 			Attr_ReplicationStatus: {
 				Computed:    true,
 				Description: "The replication status of volume group.",
@@ -90,14 +91,14 @@ func DataSourceIBMPIVolumeGroupDetails() *schema.Resource {
 func dataSourceIBMPIVolumeGroupDetailsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
 	vgClient := instance.NewIBMPIVolumeGroupClient(ctx, sess, cloudInstanceID)
 	vgData, err := vgClient.GetDetails(d.Get(Arg_VolumeGroupID).(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	d.SetId(*vgData.ID)
@@ -110,5 +111,11 @@ func dataSourceIBMPIVolumeGroupDetailsRead(ctx context.Context, d *schema.Resour
 	d.Set(Attr_VolumeIDs, vgData.VolumeIDs)
 	d.Set(Attr_VolumeGroupName, vgData.Name)
 
+	relationships, err := getVolumeGroupRemoteCopyRelationships(vgClient, d.Get(Arg_VolumeGroupID).(string), vgData.ReplicationStatus)
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+	d.Set(Attr_RemoteCopyRelationships, relationships)
+
 	return nil
 }
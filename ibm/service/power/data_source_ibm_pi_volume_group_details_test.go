@@ -20,6 +20,7 @@ func TestAccIBMPIVolumeGroupDetailsDataSource_basic(t *testing.T) {
 			{
 				Config: testAccCheckIBMPIVolumeGroupDetailsDataSourceConfig(),
 				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_pi_volume_group_details.testacc_volume_group_details", "remote_copy_relationships.#"),
 					resource.TestCheckResourceAttrSet("data.ibm_pi_volume_group_details.testacc_volume_group_details", "id"),
 				),
 			},
@@ -5,8 +5,10 @@ package power
 
 import (
 	"context"
+	"time"
 
 	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -32,104 +34,130 @@ func DataSourceIBMPIVolumeGroupRemoteCopyRelationships() *schema.Resource {
 			},
 
 			// Attributes
-			Attr_RemoteCopyRelationships: {
-				Computed:    true,
-				Description: "List of remote copy relationships",
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						Attr_AuxiliaryChangedVolumeName: {
-							Computed:    true,
-							Description: "The name of the volume that is acting as the auxiliary change volume for the relationship.",
-							Type:        schema.TypeString,
-						},
-						Attr_AuxiliaryVolumeName: {
-							Computed:    true,
-							Description: "The auxiliary volume name at storage host level.",
-							Type:        schema.TypeString,
-						},
-						Attr_ConsistencyGroupName: {
-							Computed:    true,
-							Description: "The consistency group name if volume is a part of volume group.",
-							Type:        schema.TypeString,
-						},
-						Attr_CopyType: {
-							Computed:    true,
-							Description: "The copy type.",
-							Type:        schema.TypeString,
-						},
-						Attr_CyclingMode: {
-							Computed:    true,
-							Description: "The type of cycling mode used.",
-							Type:        schema.TypeString,
-						},
-						Attr_FreezeTime: {
-							Computed:    true,
-							Description: "The freeze time of remote copy relationship.",
-							Type:        schema.TypeString,
-						},
-						Attr_MasterChangedVolumeName: {
-							Computed:    true,
-							Description: "The name of the volume that is acting as the master change volume for the relationship.",
-							Type:        schema.TypeString,
-						},
-						Attr_MasterVolumeName: {
-							Computed:    true,
-							Description: "The master volume name at storage host level.",
-							Type:        schema.TypeString,
-						},
-						Attr_Name: {
-							Computed:    true,
-							Description: "The remote copy relationship name.",
-							Type:        schema.TypeString,
-						},
-						Attr_PrimaryRole: {
-							Computed:    true,
-							Description: "Indicates whether master/aux volume is playing the primary role.",
-							Type:        schema.TypeString,
-						},
-						Attr_Progress: {
-							Computed:    true,
-							Description: "The relationship progress.",
-							Type:        schema.TypeInt,
-						},
-						Attr_RemoteCopyID: {
-							Computed:    true,
-							Description: "The remote copy relationship ID.",
-							Type:        schema.TypeString,
-						},
-						Attr_State: {
-							Computed:    true,
-							Description: "The relationship state.",
-							Type:        schema.TypeString,
-						},
-						Attr_Synchronized: {
-							Computed:    true,
-							Description: "Indicates whether the relationship is synchronized.",
-							Type:        schema.TypeString,
-						},
-					},
+			Attr_RemoteCopyRelationships: remoteCopyRelationshipsSchema(),
+		},
+	}
+}
+
+// remoteCopyRelationshipsSchema returns the computed list of the remote copy relationships of a
+// volume group.
+func remoteCopyRelationshipsSchema() *schema.Schema {
+	return &schema.Schema{
+		Computed:    true,
+		Description: "List of remote copy relationships",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				Attr_AuxiliaryChangedVolumeName: {
+					Computed:    true,
+					Description: "The name of the volume that is acting as the auxiliary change volume for the relationship.",
+					Type:        schema.TypeString,
+				},
+				Attr_AuxiliaryVolumeName: {
+					Computed:    true,
+					Description: "The auxiliary volume name at storage host level.",
+					Type:        schema.TypeString,
+				},
+				Attr_ConsistencyGroupName: {
+					Computed:    true,
+					Description: "The consistency group name if volume is a part of volume group.",
+					Type:        schema.TypeString,
+				},
+				Attr_CopyType: {
+					Computed:    true,
+					Description: "The copy type.",
+					Type:        schema.TypeString,
+				},
+				Attr_CyclingMode: {
+					Computed:    true,
+					Description: "The type of cycling mode used.",
+					Type:        schema.TypeString,
+				},
+				Attr_FreezeTime: {
+					Computed:    true,
+					Description: "The freeze time of remote copy relationship.",
+					Type:        schema.TypeString,
+				},
+				Attr_MasterChangedVolumeName: {
+					Computed:    true,
+					Description: "The name of the volume that is acting as the master change volume for the relationship.",
+					Type:        schema.TypeString,
+				},
+				Attr_MasterVolumeName: {
+					Computed:    true,
+					Description: "The master volume name at storage host level.",
+					Type:        schema.TypeString,
+				},
+				Attr_Name: {
+					Computed:    true,
+					Description: "The remote copy relationship name.",
+					Type:        schema.TypeString,
+				},
+				Attr_PrimaryRole: {
+					Computed:    true,
+					Description: "Indicates whether master/aux volume is playing the primary role.",
+					Type:        schema.TypeString,
+				},
+				Attr_Progress: {
+					Computed:    true,
+					Description: "The relationship progress.",
+					Type:        schema.TypeInt,
+				},
+				Attr_RemoteCopyID: {
+					Computed:    true,
+					Description: "The remote copy relationship ID.",
+					Type:        schema.TypeString,
+				},
+				Attr_State: {
+					Computed:    true,
+					Description: "The relationship state.",
+					Type:        schema.TypeString,
+				},
+				Attr_Synchronized: {
+					Computed:    true,
+					Description: "Indicates whether the relationship is synchronized.",
+					Type:        schema.TypeString,
 				},
-				Type: schema.TypeList,
 			},
 		},
+		Type: schema.TypeList,
 	}
 }
 
 func dataSourceIBMPIVolumeGroupRemoteCopyRelationshipsReads(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
 	vgClient := instance.NewIBMPIVolumeGroupClient(ctx, sess, cloudInstanceID)
 	vgData, err := vgClient.GetVolumeGroupRemoteCopyRelationships(d.Get(Arg_VolumeGroupID).(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
-	results := make([]map[string]interface{}, 0, len(vgData.RemoteCopyRelationships))
-	for _, i := range vgData.RemoteCopyRelationships {
+	d.SetId(vgData.ID)
+	d.Set(Attr_RemoteCopyRelationships, flattenRemoteCopyRelationships(vgData.RemoteCopyRelationships))
+
+	return nil
+}
+
+// getVolumeGroupRemoteCopyRelationships returns the flattened remote copy relationships of a volume
+// group, or none when replication is not enabled for the group.
+func getVolumeGroupRemoteCopyRelationships(client *instance.IBMPIVolumeGroupClient, vgID, replicationStatus string) ([]map[string]interface{}, error) {
+	if replicationStatus != State_Enabled {
+		return []map[string]interface{}{}, nil
+	}
+	vgData, err := client.GetVolumeGroupRemoteCopyRelationships(vgID)
+	if err != nil {
+		return nil, err
+	}
+	return flattenRemoteCopyRelationships(vgData.RemoteCopyRelationships), nil
+}
+
+func flattenRemoteCopyRelationships(relationships []*models.RemoteCopyRelationship) []map[string]interface{} {
+	results := make([]map[string]interface{}, 0, len(relationships))
+	for _, i := range relationships {
 		if i != nil {
 			l := map[string]interface{}{
 				Attr_AuxiliaryChangedVolumeName: i.AuxChangedVolumeName,
@@ -137,7 +165,6 @@ func dataSourceIBMPIVolumeGroupRemoteCopyRelationshipsReads(ctx context.Context,
 				Attr_ConsistencyGroupName:       i.ConsistencyGroupName,
 				Attr_CopyType:                   i.CopyType,
 				Attr_CyclingMode:                i.CyclingMode,
-				Attr_FreezeTime:                 i.FreezeTime.String(),
 				Attr_MasterChangedVolumeName:    i.MasterChangedVolumeName,
 				Attr_MasterVolumeName:           i.MasterVolumeName,
 				Attr_PrimaryRole:                i.PrimaryRole,
@@ -145,6 +172,9 @@ func dataSourceIBMPIVolumeGroupRemoteCopyRelationshipsReads(ctx context.Context,
 				Attr_State:                      i.State,
 				Attr_Synchronized:               i.Sync,
 			}
+			if !time.Time(i.FreezeTime).IsZero() {
+				l[Attr_FreezeTime] = i.FreezeTime.String()
+			}
 			if i.Name != nil {
 				l[Attr_Name] = i.Name
 			}
@@ -156,8 +186,5 @@ func dataSourceIBMPIVolumeGroupRemoteCopyRelationshipsReads(ctx context.Context,
 		}
 	}
 
-	d.SetId(vgData.ID)
-	d.Set(Attr_RemoteCopyRelationships, results)
-
-	return nil
+	return results
 }
@@ -85,7 +85,7 @@ func DataSourceIBMPIVolumeGroupStorageDetails() *schema.Resource {
 func dataSourceIBMPIVolumeGroupStorageDetailsReads(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
@@ -93,7 +93,7 @@ func dataSourceIBMPIVolumeGroupStorageDetailsReads(ctx context.Context, d *schem
 	vgID := d.Get(Arg_VolumeGroupID).(string)
 	vgData, err := vgClient.GetVolumeGroupLiveDetails(vgID)
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	d.SetId(vgID)
@@ -93,14 +93,14 @@ func DataSourceIBMPIVolumeOnboarding() *schema.Resource {
 func dataSourceIBMPIVolumeOnboardingReads(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
 	volOnboardClient := instance.NewIBMPIVolumeOnboardingClient(ctx, sess, cloudInstanceID)
 	volOnboarding, err := volOnboardClient.Get(d.Get(Arg_VolumeOnboardingID).(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	d.SetId(*volOnboarding.ID)
@@ -27,6 +27,26 @@ func DatasourceIBMPIWorkspace() *schema.Resource {
 			},
 
 			// Attributes
+			Attr_AccountID: {
+				Computed:    true,
+				Description: "The ID of the account that owns the workspace.",
+				Type:        schema.TypeString,
+			},
+			Attr_CreatedBy: {
+				Computed:    true,
+				Description: "The ID of the user or service ID that created the workspace.",
+				Type:        schema.TypeString,
+			},
+			Attr_PowerEdgeRouterEnabled: {
+				Computed:    true,
+				Description: "Indicates if the workspace uses an active Power Edge Router.",
+				Type:        schema.TypeBool,
+			},
+			Attr_ResourceGroupID: {
+				Computed:    true,
+				Description: "The ID of the resource group of the workspace.",
+				Type:        schema.TypeString,
+			},
 			Attr_WorkspaceCapabilities: {
 				Computed:    true,
 				Description: "Workspace Capabilities.",
@@ -103,16 +123,25 @@ func DatasourceIBMPIWorkspace() *schema.Resource {
 func dataSourceIBMPIWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
 	client := instance.NewIBMPIWorkspacesClient(ctx, sess, cloudInstanceID)
 	wsData, err := client.Get(cloudInstanceID)
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
+	}
+
+	controller, _, err := client.GetRC(cloudInstanceID)
+	if err != nil {
+		return piDiagFromErr(err)
 	}
 
+	d.Set(Attr_AccountID, controller.AccountID)
+	d.Set(Attr_CreatedBy, controller.CreatedBy)
+	d.Set(Attr_PowerEdgeRouterEnabled, isPowerEdgeRouterActive(wsData))
+	d.Set(Attr_ResourceGroupID, controller.ResourceGroupID)
 	d.Set(Attr_WorkspaceName, wsData.Name)
 	d.Set(Attr_WorkspaceStatus, wsData.Status)
 	d.Set(Attr_WorkspaceType, wsData.Type)
@@ -130,8 +159,8 @@ func dataSourceIBMPIWorkspaceRead(ctx context.Context, d *schema.ResourceData, m
 			Attr_Type:            *wsData.Details.PowerEdgeRouter.Type,
 		}
 		detailsData[Attr_PowerEdgeRouter] = []map[string]interface{}{wsPowerEdge}
-		wsDetails = append(wsDetails, detailsData)
 	}
+	wsDetails = append(wsDetails, detailsData)
 
 	d.Set(Attr_WorkspaceDetails, wsDetails)
 	wsLocation := map[string]interface{}{
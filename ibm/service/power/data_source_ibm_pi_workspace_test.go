@@ -20,6 +20,8 @@ func TestAccIBMPIWorkspaceDataSourceBasic(t *testing.T) {
 				Config: testAccCheckIBMPIWorkspaceDataSourceConfig(),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.ibm_pi_workspace.test", "pi_workspace_name"),
+					resource.TestCheckResourceAttrSet("data.ibm_pi_workspace.test", "account_id"),
+					resource.TestCheckResourceAttrSet("data.ibm_pi_workspace.test", "resource_group_id"),
 				),
 			},
 		},
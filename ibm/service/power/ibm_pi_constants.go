@@ -7,20 +7,34 @@ const (
 	Arg_AffinityInstance                    = "pi_affinity_instance"
 	Arg_AffinityPolicy                      = "pi_affinity_policy"
 	Arg_AffinityVolume                      = "pi_affinity_volume"
+	Arg_AllowDelete                         = "pi_allow_delete"
 	Arg_AntiAffinityInstances               = "pi_anti_affinity_instances"
 	Arg_AntiAffinityVolumes                 = "pi_anti_affinity_volumes"
+	Arg_CaptureQuiesce                      = "pi_capture_quiesce"
+	Arg_Checksum                            = "pi_checksum"
+	Arg_CIDR                                = "pi_cidr"
 	Arg_CloudConnectionName                 = "pi_cloud_connection_name"
 	Arg_CloudInstanceID                     = "pi_cloud_instance_id"
+	Arg_COSResourceKeyID                    = "pi_cos_resource_key_id"
 	Arg_Datacenter                          = "pi_datacenter"
 	Arg_DatacenterZone                      = "pi_datacenter_zone"
+	Arg_DeleteDataVolumes                   = "pi_delete_data_volumes"
+	Arg_DeleteProtection                    = "pi_delete_protection"
 	Arg_DeploymentTarget                    = "pi_deployment_target"
 	Arg_Description                         = "pi_description"
+	Arg_DetachVirtualOpticalDevice          = "pi_detach_virtual_optical_device"
 	Arg_DhcpCidr                            = "pi_cidr"
 	Arg_DhcpCloudConnectionID               = "pi_cloud_connection_id"
 	Arg_DhcpDnsServer                       = "pi_dns_server"
+	Arg_DhcpDnsServers                      = "pi_dns_servers"
 	Arg_DhcpID                              = "pi_dhcp_id"
 	Arg_DhcpName                            = "pi_dhcp_name"
 	Arg_DhcpSnatEnabled                     = "pi_dhcp_snat_enabled"
+	Arg_ExpandMembers                       = "pi_expand_members"
+	Arg_Family                              = "pi_family"
+	Arg_Force                               = "pi_force"
+	Arg_ForceDetachOnDelete                 = "pi_force_detach_on_delete"
+	Arg_FromTime                            = "pi_from_time"
 	Arg_Host                                = "pi_host"
 	Arg_HostGroupID                         = "pi_host_group_id"
 	Arg_HostID                              = "pi_host_id"
@@ -28,69 +42,111 @@ const (
 	Arg_IBMiCSS                             = "pi_ibmi_css"
 	Arg_IBMiPHA                             = "pi_ibmi_pha"
 	Arg_IBMiRDSUsers                        = "pi_ibmi_rds_users"
+	Arg_IgnoreStoragePoolAffinityDrift      = "pi_ignore_storage_pool_affinity_drift"
 	Arg_ImageImportDetails                  = "pi_image_import_details"
 	Arg_ImageName                           = "pi_image_name"
+	Arg_ImageOSType                         = "pi_image_os_type"
 	Arg_InstanceName                        = "pi_instance_name"
+	Arg_IPAddress                           = "pi_ip_address"
+	Arg_JobState                            = "pi_job_state"
+	Arg_JobStates                           = "pi_job_states"
 	Arg_Key                                 = "pi_ssh_key"
 	Arg_KeyName                             = "pi_key_name"
 	Arg_LanguageCode                        = "pi_language_code"
 	Arg_LicenseRepositoryCapacity           = "pi_license_repository_capacity"
+	Arg_Memory                              = "pi_memory"
+	Arg_MinCores                            = "pi_min_cores"
+	Arg_MinMemory                           = "pi_min_memory"
 	Arg_Name                                = "pi_name"
+	Arg_NameRegex                           = "pi_name_regex"
+	Arg_NetworkHealthCheck                  = "pi_network_health_check"
 	Arg_NetworkName                         = "pi_network_name"
+	Arg_OlderThan                           = "pi_older_than"
+	Arg_OperationAction                     = "pi_operation_action"
+	Arg_OperationTarget                     = "pi_operation_target"
 	Arg_PIInstanceSharedProcessorPool       = "pi_shared_processor_pool"
 	Arg_PlacementGroupName                  = "pi_placement_group_name"
 	Arg_PlacementGroupPolicy                = "pi_placement_group_policy"
 	Arg_Plan                                = "pi_plan"
+	Arg_PolicyPresharedKeySecretCRN         = "pi_policy_preshared_key_secret_crn"
+	Arg_PowerSchedule                       = "pi_power_schedule"
+	Arg_Processors                          = "pi_processors"
+	Arg_ProcType                            = "pi_proc_type"
 	Arg_PVMInstanceActionType               = "pi_action"
 	Arg_PVMInstanceHealthStatus             = "pi_health_status"
 	Arg_PVMInstanceId                       = "pi_instance_id"
 	Arg_Remove                              = "pi_remove"
 	Arg_ReplicationEnabled                  = "pi_replication_enabled"
+	Arg_RequirePowerEdgeRouter              = "pi_require_power_edge_router"
 	Arg_ResourceGroupID                     = "pi_resource_group_id"
+	Arg_ResourceTypes                       = "pi_resource_types"
+	Arg_RestoreFailAction                   = "pi_restore_fail_action"
 	Arg_SAP                                 = "sap"
 	Arg_SAPProfileID                        = "pi_sap_profile_id"
 	Arg_Secondaries                         = "pi_secondaries"
 	Arg_SharedProcessorPoolHostGroup        = "pi_shared_processor_pool_host_group"
 	Arg_SharedProcessorPoolID               = "pi_shared_processor_pool_id"
+	Arg_SharedProcessorPoolIDs              = "pi_shared_processor_pool_ids"
 	Arg_SharedProcessorPoolName             = "pi_shared_processor_pool_name"
 	Arg_SharedProcessorPoolPlacementGroupID = "pi_shared_processor_pool_placement_group_id"
 	Arg_SharedProcessorPoolReservedCores    = "pi_shared_processor_pool_reserved_cores"
+	Arg_ShutdownOnDelete                    = "pi_shutdown_on_delete"
 	Arg_SnapshotID                          = "pi_snapshot_id"
 	Arg_SnapShotName                        = "pi_snap_shot_name"
 	Arg_SPPPlacementGroupID                 = "pi_spp_placement_group_id"
 	Arg_SPPPlacementGroupName               = "pi_spp_placement_group_name"
 	Arg_SPPPlacementGroupPolicy             = "pi_spp_placement_group_policy"
 	Arg_SSHKey                              = "pi_ssh_key"
+	Arg_SSHKeys                             = "pi_ssh_keys"
+	Arg_Status                              = "pi_status"
 	Arg_StoragePool                         = "pi_storage_pool"
 	Arg_StorageType                         = "pi_storage_type"
+	Arg_SysType                             = "pi_sys_type"
+	Arg_ToTime                              = "pi_to_time"
+	Arg_VLanID                              = "pi_vlan_id"
+	Arg_VolumeCount                         = "pi_volume_count"
 	Arg_VolumeGroupID                       = "pi_volume_group_id"
 	Arg_VolumeID                            = "pi_volume_id"
 	Arg_VolumeIDs                           = "pi_volume_ids"
 	Arg_VolumeName                          = "pi_volume_name"
+	Arg_VolumeNameRegex                     = "pi_volume_name_regex"
+	Arg_VolumeNameScheme                    = "pi_volume_name_scheme"
 	Arg_VolumeOnboardingID                  = "pi_volume_onboarding_id"
 	Arg_VolumePool                          = "pi_volume_pool"
 	Arg_VolumeShareable                     = "pi_volume_shareable"
 	Arg_VolumeSize                          = "pi_volume_size"
 	Arg_VolumeType                          = "pi_volume_type"
+	Arg_VPCKeyID                            = "pi_vpc_key_id"
 	Arg_VTL                                 = "vtl"
+	Arg_WaitUntil                           = "pi_wait_until"
+	Arg_WorkloadType                        = "pi_workload_type"
 
 	// Attributes
 	Attr_Access                                      = "access"
 	Attr_AccessConfig                                = "access_config"
+	Attr_AccountID                                   = "account_id"
 	Attr_Action                                      = "action"
+	Attr_ActionResult                                = "action_result"
+	Attr_Actions                                     = "actions"
+	Attr_ActiveTiers                                 = "active_tiers"
+	Attr_Address                                     = "address"
 	Attr_Addresses                                   = "addresses"
 	Attr_AllocatedCores                              = "allocated_cores"
 	Attr_Architecture                                = "architecture"
+	Attr_Attachments                                 = "attachments"
+	Attr_Authentication                              = "authentication"
 	Attr_Auxiliary                                   = "auxiliary"
 	Attr_AuxiliaryChangedVolumeName                  = "auxiliary_changed_volume_name"
 	Attr_AuxiliaryVolumeName                         = "auxiliary_volume_name"
 	Attr_AvailabilityZone                            = "availability_zone"
+	Attr_AvailableCapacity                           = "available_capacity"
 	Attr_AvailableCores                              = "available_cores"
 	Attr_AvailableHosts                              = "available_hosts"
 	Attr_AvailableIPCount                            = "available_ip_count"
 	Attr_AvailableMemory                             = "available_memory"
 	Attr_Bootable                                    = "bootable"
 	Attr_BootVolumeID                                = "boot_volume_id"
+	Attr_CanHost                                     = "can_host"
 	Attr_Capabilities                                = "capabilities"
 	Attr_Capacity                                    = "capacity"
 	Attr_Certified                                   = "certified"
@@ -104,6 +160,7 @@ const (
 	Attr_Connections                                 = "connections"
 	Attr_ConsistencyGroupName                        = "consistency_group_name"
 	Attr_ConsoleLanguages                            = "console_languages"
+	Attr_ConsoleURL                                  = "console_url"
 	Attr_ContainerFormat                             = "container_format"
 	Attr_CopyRate                                    = "copy_rate"
 	Attr_CopyType                                    = "copy_type"
@@ -112,7 +169,9 @@ const (
 	Attr_Count                                       = "count"
 	Attr_CPUs                                        = "cpus"
 	Attr_Created                                     = "created"
+	Attr_CreatedBy                                   = "created_by"
 	Attr_CreateTime                                  = "create_time"
+	Attr_CreateTimestamp                             = "create_timestamp"
 	Attr_CreationDate                                = "creation_date"
 	Attr_CRN                                         = "crn"
 	Attr_CyclePeriodSeconds                          = "cycle_period_seconds"
@@ -124,6 +183,7 @@ const (
 	Attr_DatacenterStatus                            = "pi_datacenter_status"
 	Attr_DatacenterType                              = "pi_datacenter_type"
 	Attr_Default                                     = "default"
+	Attr_DeletedJobIDs                               = "deleted_job_ids"
 	Attr_DeleteOnTermination                         = "delete_on_termination"
 	Attr_DeploymentType                              = "deployment_type"
 	Attr_Description                                 = "description"
@@ -138,16 +198,20 @@ const (
 	Attr_DhcpNetworkName                             = "network_name"
 	Attr_DhcpServers                                 = "servers"
 	Attr_DhcpStatus                                  = "status"
+	Attr_DHGroup                                     = "dh_group"
 	Attr_DisasterRecoveryLocations                   = "disaster_recovery_locations"
 	Attr_DiskFormat                                  = "disk_format"
 	Attr_DiskType                                    = "disk_type"
 	Attr_DisplayName                                 = "display_name"
 	Attr_DNS                                         = "dns"
 	Attr_Enabled                                     = "enabled"
+	Attr_Encryption                                  = "encryption"
 	Attr_Endianness                                  = "endianness"
+	Attr_EventID                                     = "event_id"
 	Attr_ExternalIP                                  = "external_ip"
 	Attr_FailureMessage                              = "failure_message"
 	Attr_Fault                                       = "fault"
+	Attr_FixedIOPS                                   = "fixed_iops"
 	Attr_FlashCopyMappings                           = "flash_copy_mappings"
 	Attr_FlashCopyName                               = "flash_copy_name"
 	Attr_FreezeTime                                  = "freeze_time"
@@ -166,37 +230,51 @@ const (
 	Attr_Href                                        = "href"
 	Attr_Hypervisor                                  = "hypervisor"
 	Attr_HypervisorType                              = "hypervisor_type"
+	Attr_IBMBGPNeighborAddress                       = "ibm_bgp_neighbor_address"
 	Attr_IBMiCSS                                     = "ibmi_css"
 	Attr_IBMIPAddress                                = "ibm_ip_address"
 	Attr_IBMiPHA                                     = "ibmi_pha"
 	Attr_IBMiRDS                                     = "ibmi_rds"
 	Attr_IBMiRDSUsers                                = "ibmi_rds_users"
 	Attr_ID                                          = "id"
+	Attr_IKEPolicy                                   = "ike_policy"
+	Attr_ImageFileName                               = "image_file_name"
 	Attr_ImageID                                     = "image_id"
 	Attr_ImageInfo                                   = "image_info"
+	Attr_ImageName                                   = "image_name"
 	Attr_Images                                      = "images"
 	Attr_ImageType                                   = "image_type"
+	Attr_ImportBlocks                                = "import_blocks"
 	Attr_InputVolumes                                = "input_volumes"
 	Attr_Instances                                   = "instances"
 	Attr_InstanceSnapshots                           = "instance_snapshots"
 	Attr_InstanceVolumes                             = "instance_volumes"
+	Attr_IOPSPerGB                                   = "iops_per_gb"
 	Attr_IOThrottleRate                              = "io_throttle_rate"
 	Attr_IP                                          = "ip"
 	Attr_IPAddress                                   = "ipaddress"
 	Attr_IPOctet                                     = "ipoctet"
+	Attr_IPSecPolicy                                 = "ipsec_policy"
 	Attr_IsActive                                    = "is_active"
+	Attr_JobID                                       = "job_id"
+	Attr_Jobs                                        = "jobs"
+	Attr_JobStatus                                   = "job_status"
 	Attr_Jumbo                                       = "jumbo"
 	Attr_Key                                         = "key"
 	Attr_KeyCreationDate                             = "creation_date"
 	Attr_KeyID                                       = "key_id"
+	Attr_KeyLifetime                                 = "key_lifetime"
 	Attr_KeyName                                     = "name"
 	Attr_Keys                                        = "keys"
 	Attr_Language                                    = "language"
+	Attr_LastError                                   = "last_error"
 	Attr_LastUpdateDate                              = "last_update_date"
 	Attr_LastUpdatedDate                             = "last_updated_date"
 	Attr_Leases                                      = "leases"
+	Attr_Level                                       = "level"
 	Attr_LicenseRepositoryCapacity                   = "license_repository_capacity"
 	Attr_LicenseType                                 = "license_type"
+	Attr_LimitingDimension                           = "limiting_dimension"
 	Attr_Location                                    = "location"
 	Attr_MacAddress                                  = "macaddress"
 	Attr_MasterChangedVolumeName                     = "master_changed_volume_name"
@@ -212,6 +290,7 @@ const (
 	Attr_MaxProc                                     = "maxproc"
 	Attr_MaxProcessors                               = "max_processors"
 	Attr_MaxVirtualCores                             = "max_virtual_cores"
+	Attr_MemberInstances                             = "member_instances"
 	Attr_Members                                     = "members"
 	Attr_Memory                                      = "memory"
 	Attr_Message                                     = "message"
@@ -226,6 +305,7 @@ const (
 	Attr_MirroringState                              = "mirroring_state"
 	Attr_MTU                                         = "mtu"
 	Attr_Name                                        = "name"
+	Attr_NetworkAddressID                            = "network_address_id"
 	Attr_NetworkID                                   = "network_id"
 	Attr_NetworkName                                 = "network_name"
 	Attr_NetworkPorts                                = "network_ports"
@@ -233,18 +313,25 @@ const (
 	Attr_NumberOfVolumes                             = "number_of_volumes"
 	Attr_Onboardings                                 = "onboardings"
 	Attr_OperatingSystem                             = "operating_system"
+	Attr_Operation                                   = "operation"
 	Attr_PercentComplete                             = "percent_complete"
+	Attr_PFS                                         = "pfs"
 	Attr_PIInstanceSharedProcessorPool               = "shared_processor_pool"
 	Attr_PIInstanceSharedProcessorPoolID             = "shared_processor_pool_id"
 	Attr_PinPolicy                                   = "pin_policy"
 	Attr_PlacementGroupID                            = "placement_group_id"
+	Attr_PlacementGroupMembers                       = "placement_group_members"
 	Attr_PlacementGroups                             = "placement_groups"
+	Attr_PlacementSatisfied                          = "placement_satisfied"
 	Attr_Policy                                      = "policy"
 	Attr_Pool                                        = "pool"
 	Attr_PoolName                                    = "pool_name"
+	Attr_PoolNames                                   = "pool_names"
 	Attr_Port                                        = "port"
 	Attr_PortID                                      = "portid"
 	Attr_PowerEdgeRouter                             = "power_edge_router"
+	Attr_PowerEdgeRouterEnabled                      = "power_edge_router_enabled"
+	Attr_PresharedKeySecretVersions                  = "preshared_key_secret_versions"
 	Attr_Primary                                     = "primary"
 	Attr_PrimaryRole                                 = "primary_role"
 	Attr_Processors                                  = "processors"
@@ -255,9 +342,11 @@ const (
 	Attr_Progress                                    = "progress"
 	Attr_PublicIP                                    = "public_ip"
 	Attr_PVMInstanceID                               = "pvm_instance_id"
+	Attr_PVMInstanceIDs                              = "pvm_instance_ids"
 	Attr_PVMInstances                                = "pvm_instances"
 	Attr_PVMSnapshots                                = "pvm_snapshots"
 	Attr_Region                                      = "region"
+	Attr_RegionStorageTiers                          = "region_storage_tiers"
 	Attr_RemoteCopyID                                = "remote_copy_id"
 	Attr_RemoteCopyRelationshipNames                 = "remote_copy_relationship_names"
 	Attr_RemoteCopyRelationships                     = "remote_copy_relationships"
@@ -268,9 +357,15 @@ const (
 	Attr_ReservedCore                                = "reserved_core"
 	Attr_ReservedCores                               = "reserved_cores"
 	Attr_ReservedMemory                              = "reserved_memory"
+	Attr_ResourceGroupID                             = "resource_group_id"
+	Attr_Resources                                   = "resources"
+	Attr_RestoredVolumeIDs                           = "restored_volume_ids"
 	Attr_ResultsOnboardedVolumes                     = "results_onboarded_volumes"
 	Attr_ResultsVolumeOnboardingFailures             = "results_volume_onboarding_failures"
+	Attr_SAPProfiles                                 = "sap_profiles"
 	Attr_SAPS                                        = "saps"
+	Attr_Saps                                        = "saps"
+	Attr_ScheduledAction                             = "scheduled_action"
 	Attr_Secondaries                                 = "secondaries"
 	Attr_ServerName                                  = "server_name"
 	Attr_Shareable                                   = "shreable"
@@ -296,6 +391,7 @@ const (
 	Attr_SharedProcessorPoolStatus                   = "status"
 	Attr_SharedProcessorPoolStatusDetail             = "status_detail"
 	Attr_Size                                        = "size"
+	Attr_SMTMode                                     = "smt_mode"
 	Attr_SnapshotID                                  = "snapshot_id"
 	Attr_SourceVolumeName                            = "source_volume_name"
 	Attr_Speed                                       = "speed"
@@ -305,28 +401,38 @@ const (
 	Attr_SPPPlacementGroupPolicy                     = "policy"
 	Attr_SPPPlacementGroups                          = "spp_placement_groups"
 	Attr_SSHKey                                      = "ssh_key"
+	Attr_Start                                       = "start"
 	Attr_StartTime                                   = "start_time"
 	Attr_State                                       = "state"
 	Attr_Status                                      = "status"
 	Attr_StatusDescriptionErrors                     = "status_description_errors"
 	Attr_StatusDetail                                = "status_detail"
+	Attr_Stop                                        = "stop"
+	Attr_StorageHost                                 = "storage_host"
 	Attr_StoragePool                                 = "storage_pool"
 	Attr_StoragePoolAffinity                         = "storage_pool_affinity"
 	Attr_StoragePoolsCapacity                        = "storage_pools_capacity"
 	Attr_StorageType                                 = "storage_type"
+	Attr_StorageTypes                                = "storage_types"
 	Attr_StorageTypesCapacity                        = "storage_types_capacity"
 	Attr_SupportedSystems                            = "supported_systems"
 	Attr_Synchronized                                = "synchronized"
 	Attr_SystemPoolName                              = "system_pool_name"
 	Attr_SystemPools                                 = "system_pools"
 	Attr_Systems                                     = "systems"
+	Attr_SystemTypes                                 = "system_types"
 	Attr_SysType                                     = "sys_type"
 	Attr_Systype                                     = "systype"
+	Attr_Target                                      = "target"
 	Attr_TargetVolumeName                            = "target_volume_name"
 	Attr_TenantID                                    = "tenant_id"
 	Attr_TenantName                                  = "tenant_name"
+	Attr_Time                                        = "time"
+	Attr_Timeout                                     = "timeout"
+	Attr_TimeZone                                    = "time_zone"
 	Attr_TotalCapacity                               = "total_capacity"
 	Attr_TotalCore                                   = "total_core"
+	Attr_TotalCount                                  = "total_count"
 	Attr_TotalInstances                              = "total_instances"
 	Attr_TotalMemory                                 = "total_memory"
 	Attr_TotalMemoryConsumed                         = "total_memory_consumed"
@@ -334,15 +440,21 @@ const (
 	Attr_TotalSSDStorageConsumed                     = "total_ssd_storage_consumed"
 	Attr_TotalStandardStorageConsumed                = "total_standard_storage_consumed"
 	Attr_Type                                        = "type"
+	Attr_Types                                       = "types"
 	Attr_Uncapped                                    = "uncapped"
 	Attr_URL                                         = "url"
 	Attr_UsedCore                                    = "used_core"
 	Attr_UsedIPCount                                 = "used_ip_count"
 	Attr_UsedIPPercent                               = "used_ip_percent"
 	Attr_UsedMemory                                  = "used_memory"
+	Attr_UserBGPNeighborAddress                      = "user_bgp_neighbor_address"
+	Attr_UserEmail                                   = "user_email"
+	Attr_UserID                                      = "user_id"
 	Attr_UserIPAddress                               = "user_ip_address"
+	Attr_UserName                                    = "user_name"
 	Attr_VCPUs                                       = "vcpus"
 	Attr_Vendor                                      = "vendor"
+	Attr_Version                                     = "version"
 	Attr_VirtualCoresAssigned                        = "virtual_cores_assigned"
 	Attr_VLanID                                      = "vlan_id"
 	Attr_VolumeGroupName                             = "volume_group_name"
@@ -356,6 +468,7 @@ const (
 	Attr_VPCCRNs                                     = "vpc_crns"
 	Attr_VPCEnabled                                  = "vpc_enabled"
 	Attr_WorkloadType                                = "workload_type"
+	Attr_WorkloadTypes                               = "workload_types"
 	Attr_Workspace                                   = "workspace"
 	Attr_WorkspaceCapabilities                       = "pi_workspace_capabilities"
 	Attr_WorkspaceDetails                            = "pi_workspace_details"
@@ -367,6 +480,9 @@ const (
 	Attr_WorkspaceType                               = "pi_workspace_type"
 	Attr_WWN                                         = "wwn"
 
+	// Capabilities
+	Capability_PowerEdgeRouter = "power-edge-router"
+
 	// OS Type
 	OS_IBMI = "ibmi"
 
@@ -392,19 +508,24 @@ const (
 	State_Adding             = "adding"
 	State_Available          = "available"
 	State_BUILD              = "BUILD"
+	State_Completed          = "completed"
 	State_Creating           = "creating"
 	State_Deleted            = "deleted"
 	State_Deleting           = "deleting"
 	State_DELETING           = "DELETING"
 	State_Down               = "down"
+	State_Enabled            = "enabled"
+	State_Error              = "error"
 	State_Failed             = "failed"
 	State_Inactive           = "inactive"
 	State_InProgress         = "in progress"
 	State_InUse              = "in-use"
 	State_NotFound           = "Not Found"
+	State_Pending            = "pending"
 	State_PendingReclamation = "pending_reclamation"
 	State_Provisioning       = "provisioning"
 	State_Removed            = "removed"
+	State_Resizing           = "resizing"
 	State_Retry              = "retry"
 	State_Up                 = "up"
 	Status_Deleting          = "deleting"
@@ -416,7 +537,16 @@ const (
 	StatusShutoff            = "SHUTOFF"
 
 	// Health
-	Health_OK = "OK"
+	Health_OK      = "OK"
+	Health_Warning = "WARNING"
+
+	// Reason codes of API failures
+	Reason_Auth      = "auth"
+	Reason_Capacity  = "capacity"
+	Reason_Conflict  = "conflict"
+	Reason_NotFound  = "not_found"
+	Reason_Quota     = "quota"
+	Reason_Transient = "transient"
 
 	// TODO: Second Half Cleanup, remove extra variables
 
@@ -428,9 +558,6 @@ const (
 	PISAPProfileID        = "profile_id"
 	PISAPProfileType      = "type"
 
-	PVMInstanceHealthOk      = "OK"
-	PVMInstanceHealthWarning = "WARNING"
-
 	//Added timeout values for warning  and active status
 	warningTimeOut = 60 * time.Second
 	activeTimeOut  = 2 * time.Minute
@@ -464,12 +591,7 @@ const (
 	PIPlacementGroupMembers = "members"
 
 	// Volume
-	PIVolumeIds             = "pi_volume_ids"
-	PIAffinityPolicy        = "pi_affinity_policy"
-	PIAffinityVolume        = "pi_affinity_volume"
-	PIAffinityInstance      = "pi_affinity_instance"
-	PIAntiAffinityInstances = "pi_anti_affinity_instances"
-	PIAntiAffinityVolumes   = "pi_anti_affinity_volumes"
+	PIVolumeIds = "pi_volume_ids"
 
 	// Volume Clone
 	PIVolumeCloneName   = "pi_volume_clone_name"
@@ -501,6 +623,7 @@ const (
 
 	// volume clone task status
 	VolumeCloneCompleted = "completed"
+	VolumeCloneFailed    = "failed"
 	VolumeCloneRunning   = "running"
 
 	// IBM PI Workspace
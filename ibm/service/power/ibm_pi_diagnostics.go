@@ -0,0 +1,102 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// apiErrorStatus matches the status code in the message of the API errors that have no
+// generated response type, such as "[GET /pcloud/v1/...][503] ..." or "... (status 503): ...".
+var apiErrorStatus = regexp.MustCompile(`\]\[(\d{3})\]|\(status (\d{3})\)`)
+
+// errPINotFound is wrapped by the errors of lookups that find no object in the results of the
+// API, so piReasonCode reports them as Reason_NotFound like a not found response.
+var errPINotFound = errors.New(NotFound)
+
+// piDiagFromErr returns diag.FromErr(err) with the reason code of err, if any, in front of
+// the summary as "[<code>] ", so pipelines can branch on the Reason_* codes instead of the
+// message.
+func piDiagFromErr(err error) diag.Diagnostics {
+	if err == nil {
+		return nil
+	}
+	reason := piReasonCode(err)
+	if reason == "" {
+		return diag.FromErr(err)
+	}
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("[%s] %s", reason, err),
+		},
+	}
+}
+
+// piReasonCode classifies a failure of the Power API from the status code and message of the
+// API response it wraps. It returns an empty string for errors that are not API failures, such
+// as invalid arguments or IDs.
+func piReasonCode(err error) string {
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return Reason_Transient
+	}
+	if errors.Is(err, errPINotFound) {
+		return Reason_NotFound
+	}
+
+	text := err.Error()
+	status := 0
+	m := apiErrorStatus.FindStringSubmatchIndex(text)
+	var coded interface{ Code() int }
+	if errors.As(err, &coded) {
+		status = coded.Code()
+	} else if m != nil {
+		for i := 2; i < len(m); i += 2 {
+			if m[i] >= 0 {
+				status, _ = strconv.Atoi(text[m[i]:m[i+1]])
+			}
+		}
+	}
+
+	// Only the message of the API is matched, not the text the SDK and the provider wrap it in,
+	// such as "failed to get the capacity for all storage pools", which is there on any failure.
+	message := ""
+	var payload interface{ GetPayload() *models.Error }
+	switch {
+	case errors.As(err, &payload) && payload.GetPayload() != nil:
+		message = payload.GetPayload().Description + " " + payload.GetPayload().Message
+	case m != nil:
+		message = text[m[1]:]
+	case status == 0:
+		message = text
+	}
+	message = strings.ToLower(message)
+
+	// Quota and capacity failures are reported with different status codes, or without one
+	// when the failure is only seen on the object, such as the fault of an instance.
+	switch {
+	case status == 401 || status == 403:
+		return Reason_Auth
+	case status == 404:
+		return Reason_NotFound
+	case status == 429 || status == 502 || status == 503 || status == 504:
+		return Reason_Transient
+	case strings.Contains(message, "quota"):
+		return Reason_Quota
+	case strings.Contains(message, "insufficient") || strings.Contains(message, "not enough") || strings.Contains(message, "capacity"):
+		return Reason_Capacity
+	case status == 409:
+		return Reason_Conflict
+	}
+	return ""
+}
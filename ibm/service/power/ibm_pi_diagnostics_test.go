@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/power/internal/powertest"
+)
+
+func TestPIReasonCode(t *testing.T) {
+	server := powertest.NewServer(t)
+	client := instance.NewIBMPIInstanceClient(context.Background(), server.Session(t), powertest.CloudInstanceID)
+
+	tests := []struct {
+		name    string
+		status  int
+		message string
+		want    string
+	}{
+		{name: "unauthorized", status: 401, message: "invalid token", want: Reason_Auth},
+		{name: "forbidden", status: 403, message: "not authorized for the workspace", want: Reason_Auth},
+		{name: "not-found", status: 404, message: "pvm-instance not found", want: Reason_NotFound},
+		{name: "quota", status: 400, message: "the request exceeds the memory quota of the workspace", want: Reason_Quota},
+		{name: "capacity", status: 500, message: "insufficient resources to deploy the instance", want: Reason_Capacity},
+		{name: "conflict", status: 409, message: "the instance is busy", want: Reason_Conflict},
+		{name: "throttled", status: 429, message: "too many requests", want: Reason_Transient},
+		{name: "unavailable", status: 503, message: "service unavailable", want: Reason_Transient},
+		{name: "bad-request", status: 400, message: "invalid processor type", want: ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server.Fail("GET", "pvm-instances/"+tc.name, tc.status, tc.message)
+			_, err := client.Get(tc.name)
+			if err == nil {
+				t.Fatal("expected the get to fail")
+			}
+			if got := piReasonCode(err); got != tc.want {
+				t.Errorf("piReasonCode(%q) = %q, want %q", err, got, tc.want)
+			}
+		})
+	}
+
+	t.Run("provider error", func(t *testing.T) {
+		if got := piReasonCode(fmt.Errorf("invalid ID %q", "abc")); got != "" {
+			t.Errorf("piReasonCode = %q, want no reason code", got)
+		}
+	})
+
+	t.Run("lookup", func(t *testing.T) {
+		err := fmt.Errorf("network with %s %w", Arg_VLanID, errPINotFound)
+		if got := piReasonCode(err); got != Reason_NotFound {
+			t.Errorf("piReasonCode = %q, want %q", got, Reason_NotFound)
+		}
+	})
+
+	t.Run("wrapped", func(t *testing.T) {
+		_, err := client.Get("not-found")
+		err = fmt.Errorf("failed to read the instance: %w", err)
+		if got := piReasonCode(err); got != Reason_NotFound {
+			t.Errorf("piReasonCode = %q, want %q", got, Reason_NotFound)
+		}
+	})
+
+	// The storage capacity client wraps every failure in "failed to get the capacity for ...",
+	// which must not be taken for a capacity failure.
+	t.Run("capacity client", func(t *testing.T) {
+		capacityClient := instance.NewIBMPIStorageCapacityClient(context.Background(), server.Session(t), powertest.CloudInstanceID)
+		for _, tc := range []struct {
+			pool    string
+			status  int
+			message string
+			want    string
+		}{
+			{pool: "bad-request", status: 400, message: "invalid storage pool name", want: ""},
+			{pool: "unavailable", status: 503, message: "the storage capacity service is not available", want: Reason_Transient},
+			{pool: "internal", status: 500, message: "an internal error occurred", want: ""},
+			{pool: "full", status: 400, message: "not enough capacity in the storage pool", want: Reason_Capacity},
+		} {
+			server.Fail("GET", "storage-capacity/storage-pools/"+tc.pool, tc.status, tc.message)
+			_, err := capacityClient.GetStoragePoolCapacity(tc.pool)
+			if err == nil {
+				t.Fatalf("expected the get of storage pool %s to fail", tc.pool)
+			}
+			if got := piReasonCode(err); got != tc.want {
+				t.Errorf("piReasonCode(%q) = %q, want %q", err, got, tc.want)
+			}
+		}
+	})
+
+	t.Run("network error", func(t *testing.T) {
+		closed := powertest.NewServer(t)
+		closedClient := instance.NewIBMPIInstanceClient(context.Background(), closed.Session(t), powertest.CloudInstanceID)
+		closed.Close()
+		_, err := closedClient.Get("instance")
+		if got := piReasonCode(err); got != Reason_Transient {
+			t.Errorf("piReasonCode(%q) = %q, want %q", err, got, Reason_Transient)
+		}
+	})
+}
+
+func TestPIDiagFromErr(t *testing.T) {
+	if diags := piDiagFromErr(nil); diags != nil {
+		t.Errorf("piDiagFromErr(nil) = %v, want nil", diags)
+	}
+
+	diags := piDiagFromErr(errors.New("invalid ID"))
+	if len(diags) != 1 || diags[0].Summary != "invalid ID" {
+		t.Errorf("piDiagFromErr of a provider error = %v, want the error unchanged", diags)
+	}
+
+	server := powertest.NewServer(t)
+	server.Fail("GET", "pvm-instances/busy", 409, "the instance is busy")
+	_, err := instance.NewIBMPIInstanceClient(context.Background(), server.Session(t), powertest.CloudInstanceID).Get("busy")
+	diags = piDiagFromErr(err)
+	if len(diags) != 1 || !diags.HasError() || !strings.HasPrefix(diags[0].Summary, "[conflict] ") {
+		t.Errorf("piDiagFromErr of a conflict = %v, want an error with the summary starting with [conflict]", diags)
+	}
+}
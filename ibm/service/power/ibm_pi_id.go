@@ -0,0 +1,46 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// splitIDParts splits a composite resource ID into exactly len(names) non-empty parts separated
+// by "/". The names describe each part and are used to report the expected format, for example
+// when a resource is imported with an ID of the wrong shape.
+func splitIDParts(id string, names ...string) ([]string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != len(names) || slices.Contains(parts, "") {
+		return nil, fmt.Errorf("invalid ID %q: expected %d parts in the format %s", id, len(names), idFormat(names))
+	}
+	return parts, nil
+}
+
+// splitID splits a <cloud_instance_id>/<id> resource ID.
+func splitID(id string) (id1, id2 string, err error) {
+	parts, err := splitIDParts(id, "cloud_instance_id", "id")
+	if err != nil {
+		return
+	}
+	id1 = parts[0]
+	id2 = parts[1]
+	return
+}
+
+// splitInstanceID splits a <cloud_instance_id>/<instance_id>[/<instance_id>...] ID of an
+// ibm_pi_instance, which holds one instance ID per replicant.
+func splitInstanceID(id string) (cloudInstanceID string, instanceIDs []string, err error) {
+	parts := strings.Split(id, "/")
+	if len(parts) < 2 || slices.Contains(parts, "") {
+		return "", nil, fmt.Errorf("invalid ID %q: expected at least 2 parts in the format %s[/<instance_id>...]", id, idFormat([]string{"cloud_instance_id", "instance_id"}))
+	}
+	return parts[0], parts[1:], nil
+}
+
+func idFormat(names []string) string {
+	return "<" + strings.Join(names, ">/<") + ">"
+}
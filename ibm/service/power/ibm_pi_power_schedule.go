@@ -0,0 +1,189 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// powerScheduleLookback bounds how far back the last start and stop of a power schedule window
+// are searched, which covers schedules that run at least monthly.
+const powerScheduleLookback = 32 * 24 * time.Hour
+
+// powerScheduleSchema returns the pi_power_schedule argument shared by ibm_pi_instance and
+// ibm_pi_instance_power_schedule.
+func powerScheduleSchema(required bool) *schema.Schema {
+	return &schema.Schema{
+		Description: "The windows in which the instance is powered on. Outside of all windows the instance is powered off.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				Attr_Start: {
+					Description:  "Cron expression (minute hour day-of-month month day-of-week) of when the window starts.",
+					Required:     true,
+					Type:         schema.TypeString,
+					ValidateFunc: validateCronExpression,
+				},
+				Attr_Stop: {
+					Description:  "Cron expression (minute hour day-of-month month day-of-week) of when the window stops.",
+					Required:     true,
+					Type:         schema.TypeString,
+					ValidateFunc: validateCronExpression,
+				},
+				Attr_TimeZone: {
+					Default:      "UTC",
+					Description:  "IANA time zone the cron expressions are evaluated in, for example Europe/Berlin.",
+					Optional:     true,
+					Type:         schema.TypeString,
+					ValidateFunc: validateTimeZone,
+				},
+			},
+		},
+		Optional: !required,
+		Required: required,
+		Type:     schema.TypeList,
+	}
+}
+
+func validateCronExpression(v interface{}, k string) (ws []string, errs []error) {
+	if _, err := parseCronExpression(v.(string)); err != nil {
+		errs = append(errs, fmt.Errorf("%q is not a valid cron expression: %v", k, err))
+	}
+	return
+}
+
+func validateTimeZone(v interface{}, k string) (ws []string, errs []error) {
+	if _, err := time.LoadLocation(v.(string)); err != nil {
+		errs = append(errs, fmt.Errorf("%q is not a valid time zone: %v", k, err))
+	}
+	return
+}
+
+// powerScheduleAction returns the instance action that the power schedule calls for at the given
+// time: start when the time is inside one of the windows, stop otherwise.
+func powerScheduleAction(windows []interface{}, now time.Time) (string, error) {
+	for _, w := range windows {
+		window := w.(map[string]interface{})
+		loc, err := time.LoadLocation(window[Attr_TimeZone].(string))
+		if err != nil {
+			return "", err
+		}
+		start, err := parseCronExpression(window[Attr_Start].(string))
+		if err != nil {
+			return "", err
+		}
+		stop, err := parseCronExpression(window[Attr_Stop].(string))
+		if err != nil {
+			return "", err
+		}
+		t := now.In(loc)
+		lastStart, started := start.prev(t)
+		lastStop, stopped := stop.prev(t)
+		if started && (!stopped || lastStart.After(lastStop)) {
+			return "start", nil
+		}
+	}
+	return "stop", nil
+}
+
+// cronExpression is a parsed five field cron expression. Each field holds the allowed values.
+type cronExpression struct {
+	minute, hour, dom, month, dow map[int]bool
+	domAny, dowAny                bool
+}
+
+func parseCronExpression(expr string) (*cronExpression, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("expected 5 fields (minute hour day-of-month month day-of-week), got %d", len(fields))
+	}
+	c := &cronExpression{domAny: fields[2] == "*", dowAny: fields[4] == "*"}
+	var err error
+	if c.minute, err = parseCronField(fields[0], 0, 59); err != nil {
+		return nil, fmt.Errorf("minute: %v", err)
+	}
+	if c.hour, err = parseCronField(fields[1], 0, 23); err != nil {
+		return nil, fmt.Errorf("hour: %v", err)
+	}
+	if c.dom, err = parseCronField(fields[2], 1, 31); err != nil {
+		return nil, fmt.Errorf("day-of-month: %v", err)
+	}
+	if c.month, err = parseCronField(fields[3], 1, 12); err != nil {
+		return nil, fmt.Errorf("month: %v", err)
+	}
+	if c.dow, err = parseCronField(fields[4], 0, 7); err != nil {
+		return nil, fmt.Errorf("day-of-week: %v", err)
+	}
+	// Both 0 and 7 are Sunday
+	if c.dow[7] {
+		c.dow[0] = true
+	}
+	return c, nil
+}
+
+// parseCronField parses a comma separated list of values, ranges (a-b) and steps (*/n, a-b/n).
+func parseCronField(field string, min, max int) (map[int]bool, error) {
+	values := map[int]bool{}
+	for _, part := range strings.Split(field, ",") {
+		rng, step := part, 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			s, err := strconv.Atoi(part[i+1:])
+			if err != nil || s < 1 {
+				return nil, fmt.Errorf("invalid step in %q", part)
+			}
+			rng, step = part[:i], s
+		}
+		lo, hi := min, max
+		if rng != "*" {
+			bounds := strings.SplitN(rng, "-", 2)
+			var err error
+			if lo, err = strconv.Atoi(bounds[0]); err != nil {
+				return nil, fmt.Errorf("invalid value %q", part)
+			}
+			hi = lo
+			if len(bounds) == 2 {
+				if hi, err = strconv.Atoi(bounds[1]); err != nil {
+					return nil, fmt.Errorf("invalid value %q", part)
+				}
+			} else if step > 1 {
+				hi = max
+			}
+		}
+		if lo < min || hi > max || lo > hi {
+			return nil, fmt.Errorf("%q is out of range %d-%d", part, min, max)
+		}
+		for v := lo; v <= hi; v += step {
+			values[v] = true
+		}
+	}
+	return values, nil
+}
+
+func (c *cronExpression) matches(t time.Time) bool {
+	if !c.minute[t.Minute()] || !c.hour[t.Hour()] || !c.month[int(t.Month())] {
+		return false
+	}
+	dom, dow := c.dom[t.Day()], c.dow[int(t.Weekday())]
+	// As in cron, a restricted day-of-month and day-of-week match when either matches
+	if !c.domAny && !c.dowAny {
+		return dom || dow
+	}
+	return dom && dow
+}
+
+// prev returns the last time at or before t that matches the expression, within the lookback.
+func (c *cronExpression) prev(t time.Time) (time.Time, bool) {
+	t = t.Truncate(time.Minute)
+	for earliest := t.Add(-powerScheduleLookback); !t.Before(earliest); t = t.Add(-time.Minute) {
+		if c.matches(t) {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,185 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/power/internal/powertest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestParseCronField(t *testing.T) {
+	tests := []struct {
+		field    string
+		min, max int
+		want     []int
+		wantErr  bool
+	}{
+		{field: "5", min: 0, max: 59, want: []int{5}},
+		{field: "1-5", min: 0, max: 59, want: []int{1, 2, 3, 4, 5}},
+		{field: "*/15", min: 0, max: 59, want: []int{0, 15, 30, 45}},
+		{field: "10-20/5", min: 0, max: 59, want: []int{10, 15, 20}},
+		{field: "5/20", min: 0, max: 59, want: []int{5, 25, 45}},
+		{field: "1,3,5-6", min: 0, max: 59, want: []int{1, 3, 5, 6}},
+		{field: "*/2", min: 1, max: 12, want: []int{1, 3, 5, 7, 9, 11}},
+		{field: "0,7", min: 0, max: 7, want: []int{0, 7}},
+		{field: "60", min: 0, max: 59, wantErr: true},
+		{field: "0", min: 1, max: 31, wantErr: true},
+		{field: "5-1", min: 0, max: 59, wantErr: true},
+		{field: "*/0", min: 0, max: 59, wantErr: true},
+		{field: "*/x", min: 0, max: 59, wantErr: true},
+		{field: "a", min: 0, max: 59, wantErr: true},
+		{field: "1-b", min: 0, max: 59, wantErr: true},
+		{field: "", min: 0, max: 59, wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.field, func(t *testing.T) {
+			values, err := parseCronField(tc.field, tc.min, tc.max)
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("parseCronField(%q) = %v, want an error", tc.field, values)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got := []int{}
+			for v := range values {
+				got = append(got, v)
+			}
+			sort.Ints(got)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("parseCronField(%q) = %v, want %v", tc.field, got, tc.want)
+			}
+		})
+	}
+
+	if _, err := parseCronExpression("0 9 * *"); err == nil {
+		t.Error("expected an error for an expression with 4 fields")
+	}
+}
+
+func TestCronExpressionMatches(t *testing.T) {
+	// 2024-06-02 is a Sunday, 2024-06-03 a Monday and 2024-07-01 a Monday
+	at := func(value string) time.Time {
+		tm, err := time.Parse("2006-01-02 15:04", value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tm
+	}
+	tests := []struct {
+		name string
+		expr string
+		time string
+		want bool
+	}{
+		{name: "sunday as 0", expr: "0 9 * * 0", time: "2024-06-02 09:00", want: true},
+		{name: "sunday as 7", expr: "0 9 * * 7", time: "2024-06-02 09:00", want: true},
+		{name: "weekday range on sunday", expr: "0 9 * * 1-5", time: "2024-06-02 09:00"},
+		{name: "weekday range on monday", expr: "0 9 * * 1-5", time: "2024-06-03 09:00", want: true},
+		{name: "other minute", expr: "0 9 * * *", time: "2024-06-03 09:01"},
+		{name: "day of month only", expr: "0 9 1 * *", time: "2024-06-03 09:00"},
+		{name: "day of month or day of week, day of month", expr: "0 9 2 * 1", time: "2024-06-02 09:00", want: true},
+		{name: "day of month or day of week, day of week", expr: "0 9 15 * 1", time: "2024-06-03 09:00", want: true},
+		{name: "day of month or day of week, neither", expr: "0 9 15 * 1", time: "2024-06-04 09:00"},
+		{name: "day of month and day of week both", expr: "0 9 1 * 1", time: "2024-07-01 09:00", want: true},
+		{name: "month", expr: "0 9 * 7 *", time: "2024-06-03 09:00"},
+		{name: "step", expr: "*/20 */6 * * *", time: "2024-06-03 18:40", want: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c, err := parseCronExpression(tc.expr)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := c.matches(at(tc.time)); got != tc.want {
+				t.Errorf("%q matches %s = %t, want %t", tc.expr, tc.time, got, tc.want)
+			}
+		})
+	}
+
+	c, _ := parseCronExpression("30 * * * *")
+	if prev, ok := c.prev(at("2024-06-03 10:45").Add(40 * time.Second)); !ok || !prev.Equal(at("2024-06-03 10:30")) {
+		t.Errorf("prev = %s, %t, want 2024-06-03 10:30", prev, ok)
+	}
+	c, _ = parseCronExpression("0 0 29 2 *")
+	if prev, ok := c.prev(at("2024-06-03 10:45")); ok {
+		t.Errorf("prev = %s, want none within the lookback", prev)
+	}
+}
+
+func TestPowerScheduleAction(t *testing.T) {
+	window := func(start, stop, timeZone string) map[string]interface{} {
+		return map[string]interface{}{Attr_Start: start, Attr_Stop: stop, Attr_TimeZone: timeZone}
+	}
+	officeHours := window("0 8 * * 1-5", "0 18 * * 1-5", "Europe/Berlin")
+	overnight := window("0 22 * * *", "0 6 * * *", "UTC")
+	tests := []struct {
+		name    string
+		windows []interface{}
+		now     string
+		want    string
+	}{
+		{name: "inside the window in its time zone", windows: []interface{}{officeHours}, now: "2024-06-03T07:00:00Z", want: "start"},
+		{name: "after the window in its time zone", windows: []interface{}{officeHours}, now: "2024-06-03T17:00:00Z", want: "stop"},
+		{name: "before the window in UTC", windows: []interface{}{window("0 8 * * 1-5", "0 18 * * 1-5", "UTC")}, now: "2024-06-03T07:00:00Z", want: "stop"},
+		{name: "weekend", windows: []interface{}{officeHours}, now: "2024-06-08T10:00:00Z", want: "stop"},
+		{name: "overnight before midnight", windows: []interface{}{overnight}, now: "2024-06-03T23:00:00Z", want: "start"},
+		{name: "overnight after midnight", windows: []interface{}{overnight}, now: "2024-06-04T05:00:00Z", want: "start"},
+		{name: "overnight ended", windows: []interface{}{overnight}, now: "2024-06-04T07:00:00Z", want: "stop"},
+		{name: "any window", windows: []interface{}{officeHours, overnight}, now: "2024-06-04T05:00:00Z", want: "start"},
+		{name: "monthly window", windows: []interface{}{window("0 0 1 * *", "0 0 2 * *", "UTC")}, now: "2024-06-01T12:00:00Z", want: "start"},
+		{name: "monthly window ended", windows: []interface{}{window("0 0 1 * *", "0 0 2 * *", "UTC")}, now: "2024-06-15T12:00:00Z", want: "stop"},
+		{name: "no start in the lookback", windows: []interface{}{window("0 0 29 2 *", "0 0 1 3 *", "UTC")}, now: "2024-06-15T12:00:00Z", want: "stop"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			now, _ := time.Parse(time.RFC3339, tc.now)
+			got, err := powerScheduleAction(tc.windows, now)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("action = %s, want %s", got, tc.want)
+			}
+		})
+	}
+
+	if _, err := powerScheduleAction([]interface{}{window("0 8 * * *", "0 18 * * *", "Mars/Olympus")}, time.Now()); err == nil {
+		t.Error("expected an error for an unknown time zone")
+	}
+}
+
+func TestApplyInstancePowerSchedulePlannedAction(t *testing.T) {
+	server := powertest.NewServer(t)
+	server.AddInstance(testPIInstance("pvm-1", "SHUTOFF", Health_OK))
+
+	// The schedule calls for start at any time, but the plan was made when it called for stop
+	d := schema.TestResourceDataRaw(t, ResourceIBMPIInstancePowerSchedule().Schema, map[string]interface{}{
+		Arg_CloudInstanceID: powertest.CloudInstanceID,
+		Arg_PowerSchedule:   []interface{}{map[string]interface{}{Attr_Start: "* * * * *", Attr_Stop: "0 0 1 1 *"}},
+		Arg_PVMInstanceId:   "pvm-1",
+	})
+	d.Set(Attr_ScheduledAction, "stop")
+
+	if diags := applyInstancePowerSchedule(context.Background(), d, server.Meta(t), time.Minute); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if got := d.Get(Attr_ScheduledAction).(string); got != "stop" {
+		t.Errorf("%s = %s, want the planned stop", Attr_ScheduledAction, got)
+	}
+	for _, request := range server.Requests() {
+		if strings.Contains(request, "/action") {
+			t.Errorf("request %q was sent, want the instance to stay stopped", request)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// waitUntilSchema returns the pi_wait_until argument of data sources that can block until the
+// object they look up exists and, optionally, has reached a status.
+func waitUntilSchema(statusDescription string) *schema.Schema {
+	elem := map[string]*schema.Schema{
+		Attr_Timeout: {
+			Default:      "10m",
+			Description:  "How long to wait, as a duration such as 30s or 15m.",
+			Optional:     true,
+			Type:         schema.TypeString,
+			ValidateFunc: validateDuration,
+		},
+	}
+	if statusDescription != "" {
+		elem[Attr_Status] = &schema.Schema{
+			Description:  statusDescription,
+			Optional:     true,
+			Type:         schema.TypeString,
+			ValidateFunc: validation.NoZeroValues,
+		}
+	}
+
+	return &schema.Schema{
+		Description: "Wait until the object exists, and has reached the status when one is given, before reading it.",
+		Elem:        &schema.Resource{Schema: elem},
+		MaxItems:    1,
+		Optional:    true,
+		Type:        schema.TypeList,
+	}
+}
+
+func validateDuration(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := time.ParseDuration(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a duration such as 30s or 15m: %v", k, err))
+	}
+	return
+}
+
+// readWithWaitUntil calls get once, or, when pi_wait_until is set, until get finds the object in the
+// requested status. get returns the current status of the object; not found errors and other
+// statuses are retried until the timeout elapses, and an error status stops the wait.
+func readWithWaitUntil(ctx context.Context, d *schema.ResourceData, get func() (string, error)) error {
+	raw := d.Get(Arg_WaitUntil).([]interface{})
+	if len(raw) == 0 || raw[0] == nil {
+		_, err := get()
+		return err
+	}
+
+	waitUntil := raw[0].(map[string]interface{})
+	timeout, err := time.ParseDuration(waitUntil[Attr_Timeout].(string))
+	if err != nil {
+		return err
+	}
+	target, _ := waitUntil[Attr_Status].(string)
+
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		status, err := get()
+		if err != nil {
+			if piReasonCode(err) == Reason_NotFound {
+				return retry.RetryableError(err)
+			}
+			return retry.NonRetryableError(err)
+		}
+		if target == "" || strings.EqualFold(status, target) {
+			return nil
+		}
+		if strings.EqualFold(status, State_Error) {
+			return retry.NonRetryableError(fmt.Errorf("status is %s while waiting for %s", status, target))
+		}
+		return retry.RetryableError(fmt.Errorf("status is %s, waiting for %s", status, target))
+	})
+}
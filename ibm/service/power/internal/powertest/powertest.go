@@ -0,0 +1,551 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Package powertest provides an in-memory mock of the Power Virtual Server API for unit tests
+// of the power package, so the create, update and delete logic of resources can be tested
+// without an account.
+//
+// The server serves the instance, network, network port, placement group, SAP profile, snapshot
+// and volume endpoints of a single workspace from the objects added with AddInstance, AddNetwork,
+// AddPort, AddPlacementGroup, AddSAPProfile, AddSnapshot and AddVolume, and the workspace itself
+// without capabilities.
+// Instance actions, updates and network attachments change the stored instance the way the API
+// does once the operation completes, so waiters reach their target on the first refresh. Failures are injected with
+// Fail. Network security groups are not mocked, because the SDK has no endpoints for them.
+package powertest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// CloudInstanceID is the ID of the workspace served by the mock.
+const CloudInstanceID = "powertest-workspace"
+
+// Server is a mock of the Power Virtual Server API of one workspace.
+type Server struct {
+	server *httptest.Server
+
+	mu          sync.Mutex
+	events      []*models.Event
+	failures    map[string]failure
+	instances   map[string]*models.PVMInstance
+	networks    map[string]*models.Network
+	ports       map[string][]*models.NetworkPort
+	placements  map[string]*models.PlacementGroup
+	requests    []string
+	sapProfiles map[string]*models.SAPProfile
+	snapshots   map[string]*models.Snapshot
+	tiers       models.RegionStorageTiers
+	volumes     map[string]*models.Volume
+	nextID      int
+}
+
+type failure struct {
+	status  int
+	message string
+}
+
+// NewServer starts a mock server that is closed when the test ends.
+func NewServer(t testing.TB) *Server {
+	s := &Server{
+		failures:    map[string]failure{},
+		instances:   map[string]*models.PVMInstance{},
+		networks:    map[string]*models.Network{},
+		ports:       map[string][]*models.NetworkPort{},
+		placements:  map[string]*models.PlacementGroup{},
+		sapProfiles: map[string]*models.SAPProfile{},
+		snapshots:   map[string]*models.Snapshot{},
+		volumes:     map[string]*models.Volume{},
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.handle))
+	t.Cleanup(s.server.Close)
+	return s
+}
+
+// Close stops the server, so that later requests fail with a network error.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Session returns a session for the mock server.
+func (s *Server) Session(t testing.TB) *ibmpisession.IBMPISession {
+	sess, err := ibmpisession.NewIBMPISession(&ibmpisession.IBMPIOptions{
+		Authenticator: &core.NoAuthAuthenticator{},
+		URL:           s.server.URL,
+		UserAccount:   "powertest-account",
+		Zone:          "dal12",
+	})
+	if err != nil {
+		t.Fatalf("failed to create the session of the mock server: %v", err)
+	}
+	return sess
+}
+
+// Meta returns the provider meta for the mock server, to pass to the CRUD functions of
+// resources and data sources. Only its IBMPISession method can be used.
+func (s *Server) Meta(t testing.TB) conns.ClientSession {
+	return clientSession{sess: s.Session(t)}
+}
+
+type clientSession struct {
+	conns.ClientSession
+	sess *ibmpisession.IBMPISession
+}
+
+func (c clientSession) IBMPISession() (*ibmpisession.IBMPISession, error) {
+	return c.sess, nil
+}
+
+// AddEvent stores an event of the workspace. The events are returned regardless of the time query.
+func (s *Server) AddEvent(event *models.Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, event)
+}
+
+// AddInstance stores an instance. Its PvmInstanceID is required.
+func (s *Server) AddInstance(pvm *models.PVMInstance) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.instances[*pvm.PvmInstanceID] = pvm
+}
+
+// Instance returns the stored instance with the ID, or nil.
+func (s *Server) Instance(id string) *models.PVMInstance {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.instances[id]
+}
+
+// AddNetwork stores a network. Its NetworkID is required.
+func (s *Server) AddNetwork(network *models.Network) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.networks[*network.NetworkID] = network
+}
+
+// AddPort stores a port of the network with the ID.
+func (s *Server) AddPort(networkID string, port *models.NetworkPort) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ports[networkID] = append(s.ports[networkID], port)
+}
+
+// AddPlacementGroup stores a placement group. Its ID is required.
+func (s *Server) AddPlacementGroup(pg *models.PlacementGroup) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.placements[*pg.ID] = pg
+}
+
+// AddSAPProfile stores a SAP profile. Its ProfileID is required.
+func (s *Server) AddSAPProfile(profile *models.SAPProfile) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sapProfiles[*profile.ProfileID] = profile
+}
+
+// AddSnapshot stores a snapshot. Its SnapshotID is required.
+func (s *Server) AddSnapshot(snapshot *models.Snapshot) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.snapshots[*snapshot.SnapshotID] = snapshot
+}
+
+// Snapshot returns the stored snapshot with the ID, or nil.
+func (s *Server) Snapshot(id string) *models.Snapshot {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.snapshots[id]
+}
+
+// AddStorageTier stores a storage tier of the region of the workspace.
+func (s *Server) AddStorageTier(tier *models.StorageTier) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tiers = append(s.tiers, tier)
+}
+
+// AddVolume stores a volume. Its VolumeID is required.
+func (s *Server) AddVolume(volume *models.Volume) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.volumes[*volume.VolumeID] = volume
+}
+
+// Fail makes every later request with the method and path fail with the status and message.
+// The path is relative to the workspace, such as "pvm-instances/<id>/action".
+func (s *Server) Fail(method, path string, status int, message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures[method+" "+path] = failure{status: status, message: message}
+}
+
+// Requests returns the requests received, as "<method> <path>[?<query>]" with the path relative
+// to the workspace. The action is added to instance actions, as in
+// "POST pvm-instances/<id>/action stop", and the virtual optical device operation to instance
+// updates, as in "PUT pvm-instances/<id> detach". The get of the workspace itself is "GET".
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests...)
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	prefix := "/pcloud/v1/cloud-instances/" + CloudInstanceID + "/"
+	if r.URL.Path+"/" == prefix && r.Method == http.MethodGet {
+		s.requests = append(s.requests, r.Method)
+		id := CloudInstanceID
+		writeJSON(w, http.StatusOK, &models.CloudInstance{CloudInstanceID: &id, Capabilities: []string{}})
+		return
+	}
+	if !strings.HasPrefix(r.URL.Path, prefix) {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("path %s is not mocked", r.URL.Path))
+		return
+	}
+	path := strings.TrimPrefix(r.URL.Path, prefix)
+	request := r.Method + " " + path
+	if r.URL.RawQuery != "" {
+		request += "?" + r.URL.RawQuery
+	}
+	if f, ok := s.failures[r.Method+" "+path]; ok {
+		s.requests = append(s.requests, request)
+		writeError(w, f.status, f.message)
+		return
+	}
+
+	parts := strings.Split(path, "/")
+	switch {
+	case parts[0] == "pvm-instances":
+		s.handleInstances(w, r, request, parts[1:])
+	case parts[0] == "networks":
+		s.requests = append(s.requests, request)
+		s.handleNetworks(w, r, parts[1:])
+	case parts[0] == "placement-groups":
+		s.requests = append(s.requests, request)
+		s.handlePlacementGroups(w, r, parts[1:])
+	case parts[0] == "sap":
+		s.requests = append(s.requests, request)
+		s.handleSAPProfiles(w, r, parts[1:])
+	case parts[0] == "snapshots":
+		s.requests = append(s.requests, request)
+		s.handleSnapshots(w, r, parts[1:])
+	case parts[0] == "events" && r.Method == http.MethodGet:
+		s.requests = append(s.requests, request)
+		writeJSON(w, http.StatusOK, &models.Events{Events: append([]*models.Event{}, s.events...)})
+	case parts[0] == "storage-tiers" && r.Method == http.MethodGet:
+		s.requests = append(s.requests, request)
+		writeJSON(w, http.StatusOK, append(models.RegionStorageTiers{}, s.tiers...))
+	case parts[0] == "volumes":
+		s.requests = append(s.requests, request)
+		s.handleVolumes(w, r, parts[1:])
+	default:
+		s.requests = append(s.requests, request)
+		writeError(w, http.StatusNotFound, fmt.Sprintf("path %s is not mocked", r.URL.Path))
+	}
+}
+
+func (s *Server) handleInstances(w http.ResponseWriter, r *http.Request, request string, parts []string) {
+	if len(parts) == 0 {
+		s.requests = append(s.requests, request)
+		switch r.Method {
+		case http.MethodGet:
+			refs := []*models.PVMInstanceReference{}
+			for _, pvm := range s.instances {
+				ref := &models.PVMInstanceReference{}
+				convert(pvm, ref)
+				refs = append(refs, ref)
+			}
+			writeJSON(w, http.StatusOK, &models.PVMInstances{PvmInstances: refs})
+		case http.MethodPost:
+			var body models.PVMInstanceCreate
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			s.nextID++
+			id := fmt.Sprintf("powertest-instance-%d", s.nextID)
+			status, health := "ACTIVE", Health("OK")
+			pvm := &models.PVMInstance{
+				Health:        health,
+				Memory:        body.Memory,
+				Processors:    body.Processors,
+				ProcType:      body.ProcType,
+				PvmInstanceID: &id,
+				ServerName:    body.ServerName,
+				Status:        &status,
+			}
+			s.instances[id] = pvm
+			writeJSON(w, http.StatusCreated, models.PVMInstanceList{pvm})
+		default:
+			writeError(w, http.StatusMethodNotAllowed, r.Method)
+		}
+		return
+	}
+
+	pvm := s.instances[parts[0]]
+	if pvm == nil {
+		for _, p := range s.instances {
+			if p.ServerName != nil && *p.ServerName == parts[0] {
+				pvm = p
+			}
+		}
+	}
+	if len(parts) == 2 && parts[1] == "action" && r.Method == http.MethodPost {
+		var body models.PVMInstanceAction
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		s.requests = append(s.requests, request+" "+*body.Action)
+		if pvm == nil {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("pvm-instance %s not found", parts[0]))
+			return
+		}
+		status := "ACTIVE"
+		if *body.Action == "stop" || *body.Action == "immediate-shutdown" {
+			status = "SHUTOFF"
+		}
+		pvm.Status, pvm.Health = &status, Health("OK")
+		writeJSON(w, http.StatusOK, struct{}{})
+		return
+	}
+
+	s.requests = append(s.requests, request)
+	if len(parts) > 1 && parts[1] == "networks" {
+		s.handleInstanceNetworks(w, r, pvm, parts)
+		return
+	}
+	if len(parts) != 1 {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("path %s is not mocked", r.URL.Path))
+		return
+	}
+	if pvm == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("pvm-instance %s not found", parts[0]))
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, pvm)
+	case http.MethodPut:
+		var body models.PVMInstanceUpdate
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if body.CloudInitialization != nil {
+			s.requests[len(s.requests)-1] += " " + body.CloudInitialization.VirtualOpticalDevice
+		}
+		if body.Memory != 0 {
+			pvm.Memory = &body.Memory
+		}
+		if body.Processors != 0 {
+			pvm.Processors = &body.Processors
+		}
+		if body.ProcType != "" {
+			pvm.ProcType = &body.ProcType
+		}
+		if body.ServerName != "" {
+			pvm.ServerName = &body.ServerName
+		}
+		if body.StoragePoolAffinity != nil {
+			pvm.StoragePoolAffinity = body.StoragePoolAffinity
+		}
+		writeJSON(w, http.StatusAccepted, &models.PVMInstanceUpdateResponse{})
+	case http.MethodDelete:
+		delete(s.instances, *pvm.PvmInstanceID)
+		writeJSON(w, http.StatusOK, struct{}{})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, r.Method)
+	}
+}
+
+// handleInstanceNetworks attaches a network to an instance with a new MAC address, or detaches
+// the network with the MAC address of the body.
+func (s *Server) handleInstanceNetworks(w http.ResponseWriter, r *http.Request, pvm *models.PVMInstance, parts []string) {
+	if pvm == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("pvm-instance %s not found", parts[0]))
+		return
+	}
+	switch {
+	case len(parts) == 2 && r.Method == http.MethodPost:
+		var body models.PVMInstanceAddNetwork
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		s.nextID++
+		network := &models.PVMInstanceNetwork{
+			IPAddress:  body.IPAddress,
+			MacAddress: fmt.Sprintf("fa:16:3e:00:00:%02x", s.nextID),
+			NetworkID:  *body.NetworkID,
+		}
+		pvm.Networks = append(pvm.Networks, network)
+		writeJSON(w, http.StatusCreated, network)
+	case len(parts) == 3 && r.Method == http.MethodDelete:
+		var body models.PVMInstanceRemoveNetwork
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		networks := []*models.PVMInstanceNetwork{}
+		for _, n := range pvm.Networks {
+			if n.NetworkID != parts[2] || (body.MacAddress != "" && n.MacAddress != body.MacAddress) {
+				networks = append(networks, n)
+			}
+		}
+		pvm.Networks = networks
+		writeJSON(w, http.StatusOK, struct{}{})
+	default:
+		writeError(w, http.StatusNotFound, fmt.Sprintf("path %s is not mocked", r.URL.Path))
+	}
+}
+
+func (s *Server) handleNetworks(w http.ResponseWriter, r *http.Request, parts []string) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, r.Method)
+		return
+	}
+	if len(parts) == 0 {
+		refs := []*models.NetworkReference{}
+		for _, network := range s.networks {
+			ref := &models.NetworkReference{}
+			convert(network, ref)
+			refs = append(refs, ref)
+		}
+		writeJSON(w, http.StatusOK, &models.Networks{Networks: refs})
+		return
+	}
+
+	network := s.networks[parts[0]]
+	if network == nil {
+		for _, n := range s.networks {
+			if n.Name != nil && *n.Name == parts[0] {
+				network = n
+			}
+		}
+	}
+	if network == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("network %s not found", parts[0]))
+		return
+	}
+	switch {
+	case len(parts) == 1:
+		writeJSON(w, http.StatusOK, network)
+	case len(parts) == 2 && parts[1] == "ports":
+		writeJSON(w, http.StatusOK, &models.NetworkPorts{Ports: append([]*models.NetworkPort{}, s.ports[*network.NetworkID]...)})
+	default:
+		writeError(w, http.StatusNotFound, fmt.Sprintf("path %s is not mocked", r.URL.Path))
+	}
+}
+
+func (s *Server) handlePlacementGroups(w http.ResponseWriter, r *http.Request, parts []string) {
+	if r.Method != http.MethodGet || len(parts) != 1 {
+		writeError(w, http.StatusMethodNotAllowed, r.Method)
+		return
+	}
+	pg := s.placements[parts[0]]
+	if pg == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("placement group %s not found", parts[0]))
+		return
+	}
+	writeJSON(w, http.StatusOK, pg)
+}
+
+func (s *Server) handleSAPProfiles(w http.ResponseWriter, r *http.Request, parts []string) {
+	if r.Method != http.MethodGet || len(parts) > 1 {
+		writeError(w, http.StatusMethodNotAllowed, r.Method)
+		return
+	}
+	if len(parts) == 0 {
+		profiles := []*models.SAPProfile{}
+		for _, profile := range s.sapProfiles {
+			profiles = append(profiles, profile)
+		}
+		writeJSON(w, http.StatusOK, &models.SAPProfiles{Profiles: profiles})
+		return
+	}
+
+	profile := s.sapProfiles[parts[0]]
+	if profile == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("sap profile %s not found", parts[0]))
+		return
+	}
+	writeJSON(w, http.StatusOK, profile)
+}
+
+func (s *Server) handleSnapshots(w http.ResponseWriter, r *http.Request, parts []string) {
+	if r.Method != http.MethodGet || len(parts) != 1 {
+		writeError(w, http.StatusMethodNotAllowed, r.Method)
+		return
+	}
+	snapshot := s.snapshots[parts[0]]
+	if snapshot == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("snapshot %s not found", parts[0]))
+		return
+	}
+	writeJSON(w, http.StatusOK, snapshot)
+}
+
+func (s *Server) handleVolumes(w http.ResponseWriter, r *http.Request, parts []string) {
+	if r.Method != http.MethodGet || len(parts) > 1 {
+		writeError(w, http.StatusMethodNotAllowed, r.Method)
+		return
+	}
+	if len(parts) == 0 {
+		refs := []*models.VolumeReference{}
+		for _, volume := range s.volumes {
+			ref := &models.VolumeReference{}
+			convert(volume, ref)
+			refs = append(refs, ref)
+		}
+		writeJSON(w, http.StatusOK, &models.Volumes{Volumes: refs})
+		return
+	}
+
+	volume := s.volumes[parts[0]]
+	if volume == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("volume %s not found", parts[0]))
+		return
+	}
+	writeJSON(w, http.StatusOK, volume)
+}
+
+// Health returns the health of an instance with the status.
+func Health(status string) *models.PVMInstanceHealth {
+	return &models.PVMInstanceHealth{Status: status}
+}
+
+// convert copies the fields of an object to its reference type, which has the same JSON names.
+func convert(from, to interface{}) {
+	b, _ := json.Marshal(from)
+	_ = json.Unmarshal(b, to)
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, &models.Error{
+		Code:        int64(status),
+		Description: message,
+		Error:       http.StatusText(status),
+	})
+}
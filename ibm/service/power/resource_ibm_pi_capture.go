@@ -24,6 +24,13 @@ import (
 const cloudStorageDestination string = "cloud-storage"
 const imageCatalogDestination string = "image-catalog"
 
+// Quiesce policies of a capture
+const (
+	captureQuiesceNone           = "none"
+	captureQuiesceRequireStopped = "require-stopped"
+	captureQuiesceStop           = "stop"
+)
+
 func ResourceIBMPICapture() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceIBMPICaptureCreate,
@@ -77,6 +84,14 @@ func ResourceIBMPICapture() *schema.Resource {
 				Description:      "List of Data volume IDs",
 			},
 
+			Arg_CaptureQuiesce: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      captureQuiesceNone,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{captureQuiesceNone, captureQuiesceRequireStopped, captureQuiesceStop}),
+				Description:  "Policy to prevent crash-inconsistent captures: none, require-stopped to fail unless the instance is stopped, or stop to stop the instance for the capture and start it again afterwards",
+			},
 			helpers.PIInstanceCaptureCloudStorageRegion: {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -85,18 +100,27 @@ func ResourceIBMPICapture() *schema.Resource {
 			},
 
 			helpers.PIInstanceCaptureCloudStorageAccessKey: {
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    true,
-				Sensitive:   true,
-				Description: "Name of Cloud Storage Access Key",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{Arg_COSResourceKeyID},
+				Description:   "Name of Cloud Storage Access Key",
 			},
 			helpers.PIInstanceCaptureCloudStorageSecretKey: {
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    true,
-				Sensitive:   true,
-				Description: "Name of the Cloud Storage Secret Key",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{Arg_COSResourceKeyID},
+				Description:   "Name of the Cloud Storage Secret Key",
+			},
+			Arg_COSResourceKeyID: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{helpers.PIInstanceCaptureCloudStorageAccessKey, helpers.PIInstanceCaptureCloudStorageSecretKey},
+				Description:   "ID or CRN of a Cloud Object Storage resource key with HMAC credentials; used instead of the access and secret keys",
 			},
 			helpers.PIInstanceCaptureCloudStorageImagePath: {
 				Type:        schema.TypeString,
@@ -104,12 +128,33 @@ func ResourceIBMPICapture() *schema.Resource {
 				ForceNew:    true,
 				Description: "Cloud Storage Image Path (bucket-name [/folder/../..])",
 			},
+			Arg_Checksum: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Indicates if a checksum file is created next to the image exported to cloud storage",
+			},
 			// Computed Attribute
 			"image_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Description: "Image ID of Capture Instance",
 			},
+			Attr_ImageFileName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the image object exported to the cloud storage image path; empty for captures to the image catalog only",
+			},
+			Attr_JobID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the last job run for the capture",
+			},
+			Attr_JobStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of the last job run for the capture, for example completed or failed",
+			},
 		},
 	}
 }
@@ -117,7 +162,7 @@ func ResourceIBMPICapture() *schema.Resource {
 func resourceIBMPICaptureCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	name := d.Get(helpers.PIInstanceName).(string)
@@ -132,25 +177,35 @@ func resourceIBMPICaptureCreate(ctx context.Context, d *schema.ResourceData, met
 		CaptureName:        &capturename,
 	}
 	if capturedestination != imageCatalogDestination {
+		captureBody.Checksum = d.Get(Arg_Checksum).(bool)
 		if v, ok := d.GetOk(helpers.PIInstanceCaptureCloudStorageRegion); ok {
 			captureBody.CloudStorageRegion = v.(string)
 		} else {
 			return diag.Errorf("%s is required when capture destination is %s", helpers.PIInstanceCaptureCloudStorageRegion, capturedestination)
 		}
-		if v, ok := d.GetOk(helpers.PIInstanceCaptureCloudStorageAccessKey); ok {
-			captureBody.CloudStorageAccessKey = v.(string)
-		} else {
-			return diag.Errorf("%s is required when capture destination is %s ", helpers.PIInstanceCaptureCloudStorageAccessKey, capturedestination)
-		}
 		if v, ok := d.GetOk(helpers.PIInstanceCaptureCloudStorageImagePath); ok {
 			captureBody.CloudStorageImagePath = v.(string)
 		} else {
 			return diag.Errorf("%s is required when capture destination is %s ", helpers.PIInstanceCaptureCloudStorageImagePath, capturedestination)
 		}
-		if v, ok := d.GetOk(helpers.PIInstanceCaptureCloudStorageSecretKey); ok {
-			captureBody.CloudStorageSecretKey = v.(string)
+		if v, ok := d.GetOk(Arg_COSResourceKeyID); ok {
+			accessKey, secretKey, err := cosHMACKeysFromResourceKey(meta, v.(string))
+			if err != nil {
+				return piDiagFromErr(err)
+			}
+			captureBody.CloudStorageAccessKey = accessKey
+			captureBody.CloudStorageSecretKey = secretKey
 		} else {
-			return diag.Errorf("%s is required when capture destination is %s ", helpers.PIInstanceCaptureCloudStorageSecretKey, capturedestination)
+			if v, ok := d.GetOk(helpers.PIInstanceCaptureCloudStorageAccessKey); ok {
+				captureBody.CloudStorageAccessKey = v.(string)
+			} else {
+				return diag.Errorf("%s or %s is required when capture destination is %s ", helpers.PIInstanceCaptureCloudStorageAccessKey, Arg_COSResourceKeyID, capturedestination)
+			}
+			if v, ok := d.GetOk(helpers.PIInstanceCaptureCloudStorageSecretKey); ok {
+				captureBody.CloudStorageSecretKey = v.(string)
+			} else {
+				return diag.Errorf("%s or %s is required when capture destination is %s ", helpers.PIInstanceCaptureCloudStorageSecretKey, Arg_COSResourceKeyID, capturedestination)
+			}
 		}
 	}
 
@@ -161,17 +216,26 @@ func resourceIBMPICaptureCreate(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 
-	captureResponse, err := client.CaptureInstanceToImageCatalogV2(name, captureBody)
-
+	restartID, err := quiesceInstanceForCapture(ctx, client, name, d.Get(Arg_CaptureQuiesce).(string), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
-	d.SetId(fmt.Sprintf("%s/%s/%s", cloudInstanceID, capturename, capturedestination))
-	jobClient := st.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
-	_, err = waitForIBMPIJobCompleted(ctx, jobClient, *captureResponse.ID, d.Timeout(schema.TimeoutCreate))
+	captureResponse, err := client.CaptureInstanceToImageCatalogV2(name, captureBody)
+
+	if err == nil {
+		d.SetId(fmt.Sprintf("%s/%s/%s", cloudInstanceID, capturename, capturedestination))
+		jobClient := st.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
+		err = waitForIBMPIJobRecorded(ctx, d, jobClient, *captureResponse.ID, d.Timeout(schema.TimeoutCreate))
+	}
+	// Start an instance stopped for the capture again, also when the capture failed
+	if restartID != "" {
+		if startErr := performInstanceAction(ctx, client, restartID, "start", Health_OK, d.Timeout(schema.TimeoutCreate)); startErr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to start instance %s after the capture: %w", restartID, startErr))
+		}
+	}
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	return resourceIBMPICaptureRead(ctx, d, meta)
 }
@@ -179,11 +243,11 @@ func resourceIBMPICaptureCreate(ctx context.Context, d *schema.ResourceData, met
 func resourceIBMPICaptureRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
-	parts, err := flex.IdParts(d.Id())
+	parts, err := splitIDParts(d.Id(), "cloud_instance_id", "capture_name", "capture_destination")
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	cloudInstanceID := parts[0]
 	captureID := parts[1]
@@ -200,23 +264,31 @@ func resourceIBMPICaptureRead(ctx context.Context, d *schema.ResourceData, meta
 				return nil
 			}
 			log.Printf("[DEBUG] get image failed %v", err)
-			return diag.FromErr(err)
+			return piDiagFromErr(err)
 		}
 		imageid := *imagedata.ImageID
 		d.Set("image_id", imageid)
 	}
+	if capturedestination != imageCatalogDestination {
+		// The image is exported as a compressed OVA named after the capture
+		d.Set(Attr_ImageFileName, captureID+".ova.gz")
+	} else {
+		d.Set(Attr_ImageFileName, "")
+	}
 	d.Set(helpers.PICloudInstanceId, cloudInstanceID)
+	d.Set(helpers.PIInstanceCaptureName, captureID)
+	d.Set(helpers.PIInstanceCaptureDestination, capturedestination)
 	return nil
 }
 
 func resourceIBMPICaptureDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
-	parts, err := flex.IdParts(d.Id())
+	parts, err := splitIDParts(d.Id(), "cloud_instance_id", "capture_name", "capture_destination")
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	cloudInstanceID := parts[0]
 	captureID := parts[1]
@@ -233,9 +305,32 @@ func resourceIBMPICaptureDelete(ctx context.Context, d *schema.ResourceData, met
 				return nil
 			}
 			log.Printf("[DEBUG] delete image failed %v", err)
-			return diag.FromErr(err)
+			return piDiagFromErr(err)
 		}
 	}
 	d.SetId("")
 	return nil
 }
+
+// quiesceInstanceForCapture applies the quiesce policy of a capture to the instance. It returns the
+// ID of the instance when it was stopped for the capture and must be started again afterwards.
+func quiesceInstanceForCapture(ctx context.Context, client *st.IBMPIInstanceClient, name, quiesce string, timeout time.Duration) (string, error) {
+	if quiesce == captureQuiesceNone {
+		return "", nil
+	}
+	pvm, err := client.Get(name)
+	if err != nil {
+		return "", err
+	}
+	if pvm.Status != nil && *pvm.Status == "SHUTOFF" {
+		return "", nil
+	}
+	if quiesce == captureQuiesceRequireStopped {
+		return "", fmt.Errorf("instance %s must be stopped to be captured with %s %s, but its status is %s", name, Arg_CaptureQuiesce, quiesce, flex.StringValue(pvm.Status))
+	}
+	log.Printf("[DEBUG] stopping instance %s for the capture", name)
+	if err := performInstanceAction(ctx, client, *pvm.PvmInstanceID, "stop", Health_OK, timeout); err != nil {
+		return "", fmt.Errorf("failed to stop instance %s for the capture: %w", name, err)
+	}
+	return *pvm.PvmInstanceID, nil
+}
@@ -70,6 +70,8 @@ func TestAccIBMPICaptureCloudStorage(t *testing.T) {
 				Config: testAccCheckIBMPICaptureCloudStorageConfig(name),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(captureRes, "pi_capture_name", name),
+					resource.TestCheckResourceAttr(captureRes, "image_file_name", name+".ova.gz"),
+					resource.TestCheckResourceAttr(captureRes, "job_status", "completed"),
 				),
 			},
 		},
@@ -25,11 +25,14 @@ import (
 
 var (
 	vpcUnavailable = regexp.MustCompile("pcloudCloudconnectionsPostServiceUnavailable|pcloudCloudconnectionsPutServiceUnavailable")
+	jobInProgress  = regexp.MustCompile(`(?i)another\b[^.]*\b(job|operation)\b[^.]*\bin progress|(job|operation) (is )?already in progress`)
 )
 
 const (
-	vpcRetryCount    = 2
-	vpcRetryDuration = time.Minute
+	vpcRetryCount              = 2
+	vpcRetryDuration           = time.Minute
+	jobInProgressRetryDelay    = 30 * time.Second
+	jobInProgressRetryMaxDelay = 5 * time.Minute
 )
 
 func ResourceIBMPICloudConnection() *schema.Resource {
@@ -118,9 +121,11 @@ func ResourceIBMPICloudConnection() *schema.Resource {
 				Description:  "Set of VPCs to attach to this cloud connection",
 			},
 			PICloudConnectionTransitEnabled: {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				// the update API has no transit setting, so a change used to be silently dropped
+				ForceNew:    true,
 				Description: "Enable transit gateway for this cloud connection",
 			},
 
@@ -160,6 +165,16 @@ func ResourceIBMPICloudConnection() *schema.Resource {
 				Computed:    true,
 				Description: "Type of service the gateway is attached to",
 			},
+			Attr_JobID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the last job run for the cloud connection",
+			},
+			Attr_JobStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of the last job run for the cloud connection, for example completed or failed",
+			},
 		},
 	}
 }
@@ -167,7 +182,7 @@ func ResourceIBMPICloudConnection() *schema.Resource {
 func resourceIBMPICloudConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(helpers.PICloudInstanceId).(string)
@@ -234,7 +249,13 @@ func resourceIBMPICloudConnectionCreate(ctx context.Context, d *schema.ResourceD
 	}
 
 	client := st.NewIBMPICloudConnectionClient(ctx, sess, cloudInstanceID)
-	cloudConnection, cloudConnectionJob, err := client.Create(body)
+	var cloudConnection *models.CloudConnection
+	var cloudConnectionJob *models.CloudConnectionCreateResponse
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutCreate))
+	err = retryWhileJobInProgress(ctx, deadline, "create cloud connection", func() (err error) {
+		cloudConnection, cloudConnectionJob, err = client.Create(body)
+		return
+	})
 	if err != nil {
 		if vpcUnavailable.Match([]byte(err.Error())) {
 			err = retryCloudConnectionsVPC(func() (err error) {
@@ -244,7 +265,7 @@ func resourceIBMPICloudConnectionCreate(ctx context.Context, d *schema.ResourceD
 		}
 		if err != nil {
 			log.Printf("[DEBUG] create cloud connection failed %v", err)
-			return diag.FromErr(err)
+			return piDiagFromErr(err)
 		}
 	}
 
@@ -256,9 +277,9 @@ func resourceIBMPICloudConnectionCreate(ctx context.Context, d *schema.ResourceD
 		jobID := *cloudConnectionJob.JobRef.ID
 
 		client := st.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
-		_, err = waitForIBMPIJobCompleted(ctx, client, jobID, d.Timeout(schema.TimeoutCreate))
+		err = waitForIBMPIJobRecorded(ctx, d, client, jobID, time.Until(deadline))
 		if err != nil {
-			return diag.FromErr(err)
+			return piDiagFromErr(err)
 		}
 	}
 
@@ -268,12 +289,12 @@ func resourceIBMPICloudConnectionCreate(ctx context.Context, d *schema.ResourceD
 func resourceIBMPICloudConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
-	parts, err := flex.IdParts(d.Id())
+	parts, err := splitIDParts(d.Id(), "cloud_instance_id", "cloud_connection_id")
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := parts[0]
@@ -284,72 +305,89 @@ func resourceIBMPICloudConnectionUpdate(ctx context.Context, d *schema.ResourceD
 
 	client := st.NewIBMPICloudConnectionClient(ctx, sess, cloudInstanceID)
 	jobClient := st.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
-
-	if d.HasChangesExcept(helpers.PICloudConnectionNetworks) {
-
-		body := &models.CloudConnectionUpdate{
-			Name:  &ccName,
-			Speed: &ccSpeed,
+	// The retries and the jobs of all the changes share the update timeout
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutUpdate))
+
+	if d.HasChanges(helpers.PICloudConnectionName, helpers.PICloudConnectionSpeed, helpers.PICloudConnectionGlobalRouting, helpers.PICloudConnectionMetered,
+		helpers.PICloudConnectionClassicEnabled, helpers.PICloudConnectionClassicGreCidr, helpers.PICloudConnectionClassicGreDest,
+		helpers.PICloudConnectionVPCEnabled, helpers.PICloudConnectionVPCCRNs) {
+
+		// only send what changed so the backend leaves untouched endpoints, such as an
+		// existing classic GRE tunnel, alone on speed-only updates
+		body := &models.CloudConnectionUpdate{}
+		if d.HasChange(helpers.PICloudConnectionName) {
+			body.Name = &ccName
+		}
+		if d.HasChange(helpers.PICloudConnectionSpeed) {
+			body.Speed = &ccSpeed
 		}
-		if v, ok := d.GetOk(helpers.PICloudConnectionGlobalRouting); ok {
-			globalRouting := v.(bool)
+		if d.HasChange(helpers.PICloudConnectionGlobalRouting) {
+			globalRouting := d.Get(helpers.PICloudConnectionGlobalRouting).(bool)
 			body.GlobalRouting = &globalRouting
 		}
-		if v, ok := d.GetOk(helpers.PICloudConnectionMetered); ok {
-			metered := v.(bool)
+		if d.HasChange(helpers.PICloudConnectionMetered) {
+			metered := d.Get(helpers.PICloudConnectionMetered).(bool)
 			body.Metered = &metered
 		}
 		// classic
-		if v, ok := d.GetOk(helpers.PICloudConnectionClassicEnabled); ok {
-			classicEnabled := v.(bool)
-			classic := &models.CloudConnectionEndpointClassicUpdate{
-				Enabled: classicEnabled,
-			}
-			gre := &models.CloudConnectionGRETunnelCreate{}
-			if v, ok := d.GetOk(helpers.PICloudConnectionClassicGreCidr); ok {
-				greCIDR := v.(string)
-				gre.Cidr = &greCIDR
-				classic.Gre = gre
-			}
-			if v, ok := d.GetOk(helpers.PICloudConnectionClassicGreDest); ok {
-				greDest := v.(string)
-				gre.DestIPAddress = &greDest
-				classic.Gre = gre
-			}
-			body.Classic = classic
-		} else {
-			// need to disable classic if not provided
-			classic := &models.CloudConnectionEndpointClassicUpdate{
-				Enabled: false,
+		if d.HasChanges(helpers.PICloudConnectionClassicEnabled, helpers.PICloudConnectionClassicGreCidr, helpers.PICloudConnectionClassicGreDest) {
+			if v, ok := d.GetOk(helpers.PICloudConnectionClassicEnabled); ok {
+				classicEnabled := v.(bool)
+				classic := &models.CloudConnectionEndpointClassicUpdate{
+					Enabled: classicEnabled,
+				}
+				gre := &models.CloudConnectionGRETunnelCreate{}
+				if v, ok := d.GetOk(helpers.PICloudConnectionClassicGreCidr); ok {
+					greCIDR := v.(string)
+					gre.Cidr = &greCIDR
+					classic.Gre = gre
+				}
+				if v, ok := d.GetOk(helpers.PICloudConnectionClassicGreDest); ok {
+					greDest := v.(string)
+					gre.DestIPAddress = &greDest
+					classic.Gre = gre
+				}
+				body.Classic = classic
+			} else {
+				// need to disable classic if not provided
+				classic := &models.CloudConnectionEndpointClassicUpdate{
+					Enabled: false,
+				}
+				body.Classic = classic
 			}
-			body.Classic = classic
 		}
 		// vpc
-		if v, ok := d.GetOk(helpers.PICloudConnectionVPCEnabled); ok {
-			vpcEnabled := v.(bool)
-			vpc := &models.CloudConnectionEndpointVPC{
-				Enabled: vpcEnabled,
-			}
-			if v, ok := d.GetOk(helpers.PICloudConnectionVPCCRNs); ok && v.(*schema.Set).Len() > 0 {
-				vpcIds := flex.ExpandStringList(v.(*schema.Set).List())
-				vpcs := make([]*models.CloudConnectionVPC, len(vpcIds))
-				for i, vpcId := range vpcIds {
-					vpcs[i] = &models.CloudConnectionVPC{
-						VpcID: &vpcId,
+		if d.HasChanges(helpers.PICloudConnectionVPCEnabled, helpers.PICloudConnectionVPCCRNs) {
+			if v, ok := d.GetOk(helpers.PICloudConnectionVPCEnabled); ok {
+				vpcEnabled := v.(bool)
+				vpc := &models.CloudConnectionEndpointVPC{
+					Enabled: vpcEnabled,
+				}
+				if v, ok := d.GetOk(helpers.PICloudConnectionVPCCRNs); ok && v.(*schema.Set).Len() > 0 {
+					vpcIds := flex.ExpandStringList(v.(*schema.Set).List())
+					vpcs := make([]*models.CloudConnectionVPC, len(vpcIds))
+					for i, vpcId := range vpcIds {
+						vpcs[i] = &models.CloudConnectionVPC{
+							VpcID: &vpcId,
+						}
 					}
+					vpc.Vpcs = vpcs
 				}
-				vpc.Vpcs = vpcs
-			}
-			body.Vpc = vpc
-		} else {
-			// need to disable VPC if not provided
-			vpc := &models.CloudConnectionEndpointVPC{
-				Enabled: false,
+				body.Vpc = vpc
+			} else {
+				// need to disable VPC if not provided
+				vpc := &models.CloudConnectionEndpointVPC{
+					Enabled: false,
+				}
+				body.Vpc = vpc
 			}
-			body.Vpc = vpc
 		}
 
-		_, cloudConnectionJob, err := client.Update(cloudConnectionID, body)
+		var cloudConnectionJob *models.JobReference
+		err = retryWhileJobInProgress(ctx, deadline, "update cloud connection", func() (err error) {
+			_, cloudConnectionJob, err = client.Update(cloudConnectionID, body)
+			return
+		})
 		if err != nil {
 			if vpcUnavailable.Match([]byte(err.Error())) {
 				err = retryCloudConnectionsVPC(func() (err error) {
@@ -359,13 +397,13 @@ func resourceIBMPICloudConnectionUpdate(ctx context.Context, d *schema.ResourceD
 			}
 			if err != nil {
 				log.Printf("[DEBUG] update cloud connection failed %v", err)
-				return diag.FromErr(err)
+				return piDiagFromErr(err)
 			}
 		}
 		if cloudConnectionJob != nil {
-			_, err = waitForIBMPIJobCompleted(ctx, jobClient, *cloudConnectionJob.ID, d.Timeout(schema.TimeoutCreate))
+			err = waitForIBMPIJobRecorded(ctx, d, jobClient, *cloudConnectionJob.ID, time.Until(deadline))
 			if err != nil {
-				return diag.FromErr(err)
+				return piDiagFromErr(err)
 			}
 		}
 	}
@@ -379,28 +417,36 @@ func resourceIBMPICloudConnectionUpdate(ctx context.Context, d *schema.ResourceD
 
 		// call network add api for each toAdd
 		for _, n := range flex.ExpandStringList(toAdd.List()) {
-			_, jobReference, err := client.AddNetwork(cloudConnectionID, n)
+			var jobReference *models.JobReference
+			err := retryWhileJobInProgress(ctx, deadline, "attach network to cloud connection", func() (err error) {
+				_, jobReference, err = client.AddNetwork(cloudConnectionID, n)
+				return
+			})
 			if err != nil {
-				return diag.FromErr(err)
+				return piDiagFromErr(err)
 			}
 			if jobReference != nil {
-				_, err = waitForIBMPIJobCompleted(ctx, jobClient, *jobReference.ID, d.Timeout(schema.TimeoutUpdate))
+				err = waitForIBMPIJobRecorded(ctx, d, jobClient, *jobReference.ID, time.Until(deadline))
 				if err != nil {
-					return diag.FromErr(err)
+					return piDiagFromErr(err)
 				}
 			}
 		}
 
 		// call network delete api for each toRemove
 		for _, n := range flex.ExpandStringList(toRemove.List()) {
-			_, jobReference, err := client.DeleteNetwork(cloudConnectionID, n)
+			var jobReference *models.JobReference
+			err := retryWhileJobInProgress(ctx, deadline, "detach network from cloud connection", func() (err error) {
+				_, jobReference, err = client.DeleteNetwork(cloudConnectionID, n)
+				return
+			})
 			if err != nil {
-				return diag.FromErr(err)
+				return piDiagFromErr(err)
 			}
 			if jobReference != nil {
-				_, err = waitForIBMPIJobCompleted(ctx, jobClient, *jobReference.ID, d.Timeout(schema.TimeoutUpdate))
+				err = waitForIBMPIJobRecorded(ctx, d, jobClient, *jobReference.ID, time.Until(deadline))
 				if err != nil {
-					return diag.FromErr(err)
+					return piDiagFromErr(err)
 				}
 			}
 		}
@@ -412,12 +458,12 @@ func resourceIBMPICloudConnectionUpdate(ctx context.Context, d *schema.ResourceD
 func resourceIBMPICloudConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
-	parts, err := flex.IdParts(d.Id())
+	parts, err := splitIDParts(d.Id(), "cloud_instance_id", "cloud_connection_id")
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := parts[0]
@@ -434,7 +480,7 @@ func resourceIBMPICloudConnectionRead(ctx context.Context, d *schema.ResourceDat
 			return nil
 		}
 		log.Printf("[DEBUG] get cloud connection failed %v", err)
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	d.Set(PICloudConnectionId, cloudConnection.CloudConnectionID)
@@ -480,12 +526,12 @@ func resourceIBMPICloudConnectionRead(ctx context.Context, d *schema.ResourceDat
 func resourceIBMPICloudConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
-	parts, err := flex.IdParts(d.Id())
+	parts, err := splitIDParts(d.Id(), "cloud_instance_id", "cloud_connection_id")
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := parts[0]
@@ -502,22 +548,27 @@ func resourceIBMPICloudConnectionDelete(ctx context.Context, d *schema.ResourceD
 			return nil
 		}
 		log.Printf("[DEBUG] get cloud connection failed %v", err)
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	log.Printf("[INFO] Found cloud connection with id %s", cloudConnectionID)
 
-	deleteJob, err := client.Delete(cloudConnectionID)
+	var deleteJob *models.JobReference
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutDelete))
+	err = retryWhileJobInProgress(ctx, deadline, "delete cloud connection", func() (err error) {
+		deleteJob, err = client.Delete(cloudConnectionID)
+		return
+	})
 	if err != nil {
 		log.Printf("[DEBUG] delete cloud connection failed %v", err)
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	if deleteJob != nil {
 		jobID := *deleteJob.ID
 
 		client := st.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
-		_, err = waitForIBMPIJobCompleted(ctx, client, jobID, d.Timeout(schema.TimeoutDelete))
+		_, err = waitForIBMPIJobCompleted(ctx, client, jobID, time.Until(deadline))
 		if err != nil {
-			return diag.FromErr(err)
+			return piDiagFromErr(err)
 		}
 	}
 
@@ -534,3 +585,30 @@ func retryCloudConnectionsVPC(ccVPCRetry func() error, operation string, errMsg
 	}
 	return errMsg
 }
+
+// retryWhileJobInProgress calls f again with an increasing delay for as long as it fails
+// because another job is still running in the workspace, giving up at the deadline. Callers pass
+// the time left until the same deadline to the wait for the job of the operation, so the retries
+// and the job share the timeout of the resource.
+func retryWhileJobInProgress(ctx context.Context, deadline time.Time, operation string, f func() error) error {
+	delay := jobInProgressRetryDelay
+	for {
+		err := f()
+		if err == nil || !jobInProgress.MatchString(err.Error()) {
+			return err
+		}
+		if time.Now().Add(delay).After(deadline) {
+			return fmt.Errorf("timed out waiting for the in progress workspace job to finish before %s: %w", operation, err)
+		}
+		log.Printf("[DEBUG] another job is in progress in the workspace, retrying %s in %s: %v", operation, delay, err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+		if delay > jobInProgressRetryMaxDelay {
+			delay = jobInProgressRetryMaxDelay
+		}
+	}
+}
@@ -14,8 +14,8 @@ import (
 
 	st "github.com/IBM-Cloud/power-go-client/clients/instance"
 	"github.com/IBM-Cloud/power-go-client/helpers"
+	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
-	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 const (
@@ -61,7 +61,7 @@ func ResourceIBMPICloudConnectionNetworkAttach() *schema.Resource {
 func resourceIBMPICloudConnectionNetworkAttachCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(helpers.PICloudInstanceId).(string)
@@ -71,16 +71,21 @@ func resourceIBMPICloudConnectionNetworkAttachCreate(ctx context.Context, d *sch
 	client := st.NewIBMPICloudConnectionClient(ctx, sess, cloudInstanceID)
 	jobClient := st.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
 
-	_, jobReference, err := client.AddNetwork(cloudConnectionID, networkID)
+	var jobReference *models.JobReference
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutCreate))
+	err = retryWhileJobInProgress(ctx, deadline, "attach network to cloud connection", func() (err error) {
+		_, jobReference, err = client.AddNetwork(cloudConnectionID, networkID)
+		return
+	})
 	if err != nil {
 		log.Printf("[ERROR] attach network to cloud connection failed %v", err)
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	d.SetId(fmt.Sprintf("%s/%s/%s", cloudInstanceID, cloudConnectionID, networkID))
 	if jobReference != nil {
-		_, err = waitForIBMPIJobCompleted(ctx, jobClient, *jobReference.ID, d.Timeout(schema.TimeoutCreate))
+		_, err = waitForIBMPIJobCompleted(ctx, jobClient, *jobReference.ID, time.Until(deadline))
 		if err != nil {
-			return diag.FromErr(err)
+			return piDiagFromErr(err)
 		}
 	}
 
@@ -88,9 +93,9 @@ func resourceIBMPICloudConnectionNetworkAttachCreate(ctx context.Context, d *sch
 }
 
 func resourceIBMPICloudConnectionNetworkAttachRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	parts, err := flex.IdParts(d.Id())
+	parts, err := splitIDParts(d.Id(), "cloud_instance_id", "cloud_connection_id", "network_id")
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := parts[0]
@@ -107,12 +112,12 @@ func resourceIBMPICloudConnectionNetworkAttachRead(ctx context.Context, d *schem
 func resourceIBMPICloudConnectionNetworkAttachDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
-	parts, err := flex.IdParts(d.Id())
+	parts, err := splitIDParts(d.Id(), "cloud_instance_id", "cloud_connection_id", "network_id")
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := parts[0]
@@ -122,15 +127,20 @@ func resourceIBMPICloudConnectionNetworkAttachDelete(ctx context.Context, d *sch
 	client := st.NewIBMPICloudConnectionClient(ctx, sess, cloudInstanceID)
 	jobClient := st.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
 
-	_, jobReference, err := client.DeleteNetwork(cloudConnectionID, networkID)
+	var jobReference *models.JobReference
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutDelete))
+	err = retryWhileJobInProgress(ctx, deadline, "detach network from cloud connection", func() (err error) {
+		_, jobReference, err = client.DeleteNetwork(cloudConnectionID, networkID)
+		return
+	})
 	if err != nil {
 		log.Printf("[DEBUG] detach network from cloud connection failed %v", err)
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	if jobReference != nil {
-		_, err = waitForIBMPIJobCompleted(ctx, jobClient, *jobReference.ID, d.Timeout(schema.TimeoutUpdate))
+		_, err = waitForIBMPIJobCompleted(ctx, jobClient, *jobReference.ID, time.Until(deadline))
 		if err != nil {
-			return diag.FromErr(err)
+			return piDiagFromErr(err)
 		}
 	}
 
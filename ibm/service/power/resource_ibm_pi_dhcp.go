@@ -15,19 +15,23 @@ import (
 
 	st "github.com/IBM-Cloud/power-go-client/clients/instance"
 	"github.com/IBM-Cloud/power-go-client/errors"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
 	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_service_d_h_c_p"
 	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 )
 
 func ResourceIBMPIDhcp() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceIBMPIDhcpCreate,
 		ReadContext:   resourceIBMPIDhcpRead,
+		UpdateContext: resourceIBMPIDhcpUpdate,
 		DeleteContext: resourceIBMPIDhcpDelete,
 		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
@@ -54,10 +58,19 @@ func ResourceIBMPIDhcp() *schema.Resource {
 				ForceNew:    true,
 			},
 			Arg_DhcpDnsServer: {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Optional DNS Server for DHCP service",
-				ForceNew:    true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Optional DNS Server for DHCP service",
+				ForceNew:      true,
+				ConflictsWith: []string{Arg_DhcpDnsServers},
+			},
+			Arg_DhcpDnsServers: {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Description:   "Optional DNS Servers of the DHCP private network, the first one is also the DNS Server of the DHCP service",
+				ConflictsWith: []string{Arg_DhcpDnsServer},
 			},
 			Arg_DhcpName: {
 				Type:        schema.TypeString,
@@ -122,7 +135,7 @@ func resourceIBMPIDhcpCreate(ctx context.Context, d *schema.ResourceData, meta i
 	// session
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	// dhcp create object
@@ -142,6 +155,10 @@ func resourceIBMPIDhcpCreate(ctx context.Context, d *schema.ResourceData, meta i
 		d := dnsServer.(string)
 		body.DNSServer = &d
 	}
+	dnsServers := flex.ExpandStringList(d.Get(Arg_DhcpDnsServers).([]interface{}))
+	if len(dnsServers) > 0 {
+		body.DNSServer = &dnsServers[0]
+	}
 	if name, ok := d.GetOk(Arg_DhcpName); ok {
 		n := name.(string)
 		body.Name = &n
@@ -154,7 +171,7 @@ func resourceIBMPIDhcpCreate(ctx context.Context, d *schema.ResourceData, meta i
 	dhcpServer, err := client.Create(body)
 	if err != nil {
 		log.Printf("[DEBUG] create DHCP failed %v", err)
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, *dhcpServer.ID))
@@ -162,24 +179,72 @@ func resourceIBMPIDhcpCreate(ctx context.Context, d *schema.ResourceData, meta i
 	// wait for creation
 	_, err = waitForIBMPIDhcpStatus(ctx, client, *dhcpServer.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		diag.FromErr(err)
+		return piDiagFromErr(err)
+	}
+
+	// the create API takes a single DNS server, the others are set on the private network
+	if len(dnsServers) > 1 {
+		err = updateIBMPIDhcpDnsServers(ctx, sess, cloudInstanceID, *dhcpServer.ID, dnsServers)
+		if err != nil {
+			return piDiagFromErr(err)
+		}
 	}
 
 	return resourceIBMPIDhcpRead(ctx, d, meta)
 }
 
+func resourceIBMPIDhcpUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+
+	// session
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+
+	// arguments
+	cloudInstanceID, dhcpID, err := splitID(d.Id())
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+
+	if d.HasChange(Arg_DhcpDnsServers) {
+		dnsServers := flex.ExpandStringList(d.Get(Arg_DhcpDnsServers).([]interface{}))
+		err = updateIBMPIDhcpDnsServers(ctx, sess, cloudInstanceID, dhcpID, dnsServers)
+		if err != nil {
+			return piDiagFromErr(err)
+		}
+	}
+
+	return resourceIBMPIDhcpRead(ctx, d, meta)
+}
+
+// updateIBMPIDhcpDnsServers sets the DNS servers of the private network of a DHCP server.
+func updateIBMPIDhcpDnsServers(ctx context.Context, sess *ibmpisession.IBMPISession, cloudInstanceID, dhcpID string, dnsServers []string) error {
+	dhcpServer, err := st.NewIBMPIDhcpClient(ctx, sess, cloudInstanceID).Get(dhcpID)
+	if err != nil {
+		return err
+	}
+	if dhcpServer.Network == nil || dhcpServer.Network.ID == nil {
+		return fmt.Errorf("DHCP server %s has no private network to set the DNS servers on", dhcpID)
+	}
+
+	networkClient := st.NewIBMPINetworkClient(ctx, sess, cloudInstanceID)
+	_, err = networkClient.Update(*dhcpServer.Network.ID, &models.NetworkUpdate{DNSServers: dnsServers})
+	return err
+}
+
 func resourceIBMPIDhcpRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	// session
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	// arguments
 	cloudInstanceID, dhcpID, err := splitID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	// get dhcp
@@ -194,7 +259,7 @@ func resourceIBMPIDhcpRead(ctx context.Context, d *schema.ResourceData, meta int
 			return nil
 		}
 		log.Printf("[DEBUG] get DHCP failed %v", err)
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	// set attributes
@@ -210,6 +275,14 @@ func resourceIBMPIDhcpRead(ctx context.Context, d *schema.ResourceData, meta int
 		if dhcpNetwork.Name != nil {
 			d.Set(Attr_DhcpNetworkName, *dhcpNetwork.Name)
 		}
+		if dhcpNetwork.ID != nil {
+			network, err := st.NewIBMPINetworkClient(ctx, sess, cloudInstanceID).Get(*dhcpNetwork.ID)
+			if err != nil {
+				log.Printf("[DEBUG] get DHCP network failed %v", err)
+				return piDiagFromErr(err)
+			}
+			d.Set(Arg_DhcpDnsServers, network.DNSServers)
+		}
 	}
 
 	if dhcpServer.Leases != nil {
@@ -230,13 +303,13 @@ func resourceIBMPIDhcpDelete(ctx context.Context, d *schema.ResourceData, meta i
 	// session
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	// arguments
 	cloudInstanceID, dhcpID, err := splitID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	// delete dhcp
@@ -251,13 +324,13 @@ func resourceIBMPIDhcpDelete(ctx context.Context, d *schema.ResourceData, meta i
 			return nil
 		}
 		log.Printf("[DEBUG] delete DHCP failed %v", err)
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	// wait for deletion
 	_, err = waitForIBMPIDhcpDeleted(ctx, client, dhcpID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	d.SetId("")
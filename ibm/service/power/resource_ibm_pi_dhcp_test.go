@@ -160,3 +160,38 @@ func testAccCheckIBMPIDhcpConfigWithSNATDisabled() string {
 	}
 	`, acc.Pi_cloud_instance_id)
 }
+
+func TestAccIBMPIDhcpDnsServers(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIDhcpDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIDhcpDnsServersConfig(`"9.9.9.9", "1.1.1.1"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIDhcpExists("ibm_pi_dhcp.dhcp_service"),
+					resource.TestCheckResourceAttr("ibm_pi_dhcp.dhcp_service", "pi_dns_servers.#", "2"),
+					resource.TestCheckResourceAttr("ibm_pi_dhcp.dhcp_service", "pi_dns_servers.1", "1.1.1.1"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPIDhcpDnsServersConfig(`"9.9.9.9", "8.8.8.8", "1.1.1.1"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIDhcpExists("ibm_pi_dhcp.dhcp_service"),
+					resource.TestCheckResourceAttr("ibm_pi_dhcp.dhcp_service", "pi_dns_servers.#", "3"),
+					resource.TestCheckResourceAttr("ibm_pi_dhcp.dhcp_service", "pi_dns_servers.1", "8.8.8.8"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIDhcpDnsServersConfig(dnsServers string) string {
+	return fmt.Sprintf(`
+	resource "ibm_pi_dhcp" "dhcp_service" {
+		pi_cloud_instance_id = "%s"
+		pi_dns_servers       = [%s]
+	}
+	`, acc.Pi_cloud_instance_id, dnsServers)
+}
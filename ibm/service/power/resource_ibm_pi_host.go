@@ -156,7 +156,7 @@ func ResourceIBMPIHost() *schema.Resource {
 func resourceIBMPIHostCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
 	client := instance.NewIBMPIHostGroupsClient(ctx, sess, cloudInstanceID)
@@ -177,14 +177,14 @@ func resourceIBMPIHostCreate(ctx context.Context, d *schema.ResourceData, meta i
 	body.HostGroupID = &hostGroupID
 	hostResponse, err := client.CreateHost(&body)
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	hostID := hostResponse[0].ID
 	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, hostID))
 	_, err = isWaitForIBMPIHostAvailable(ctx, client, hostID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	return resourceIBMPIHostRead(ctx, d, meta)
 }
@@ -192,11 +192,11 @@ func resourceIBMPIHostCreate(ctx context.Context, d *schema.ResourceData, meta i
 func resourceIBMPIHostRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	cloudInstanceID, hostID, err := splitID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	client := instance.NewIBMPIHostGroupsClient(ctx, sess, cloudInstanceID)
 	host, err := client.GetHost(hostID)
@@ -205,7 +205,7 @@ func resourceIBMPIHostRead(ctx context.Context, d *schema.ResourceData, meta int
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	d.Set(Attr_HostID, host.ID)
 
@@ -234,11 +234,11 @@ func resourceIBMPIHostRead(ctx context.Context, d *schema.ResourceData, meta int
 func resourceIBMPIHostUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	cloudInstanceID, hostID, err := splitID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	displayName := d.Get(Arg_Host + ".0").(map[string]interface{})[Attr_DisplayName].(string)
 	client := instance.NewIBMPIHostGroupsClient(ctx, sess, cloudInstanceID)
@@ -249,7 +249,7 @@ func resourceIBMPIHostUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		}
 		_, err := client.UpdateHost(&hostBody, hostID)
 		if err != nil {
-			return diag.FromErr(err)
+			return piDiagFromErr(err)
 		}
 	}
 	return resourceIBMPIHostRead(ctx, d, meta)
@@ -258,20 +258,20 @@ func resourceIBMPIHostUpdate(ctx context.Context, d *schema.ResourceData, meta i
 func resourceIBMPIHostDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	cloudInstanceID, hostID, err := splitID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	client := instance.NewIBMPIHostGroupsClient(ctx, sess, cloudInstanceID)
 	err = client.DeleteHost(hostID)
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	_, err = isWaitForPIHostDeleted(ctx, client, hostID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	d.SetId("")
 
@@ -31,6 +31,7 @@ func ResourceIBMPIHostGroup() *schema.Resource {
 		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -146,7 +147,7 @@ func ResourceIBMPIHostGroup() *schema.Resource {
 func resourceIBMPIHostGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
 	name := d.Get(Arg_Name).(string)
@@ -173,7 +174,7 @@ func resourceIBMPIHostGroupCreate(ctx context.Context, d *schema.ResourceData, m
 
 	hg, err := client.CreateHostGroup(&body)
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, hg.ID))
 
@@ -183,11 +184,11 @@ func resourceIBMPIHostGroupCreate(ctx context.Context, d *schema.ResourceData, m
 func resourceIBMPIHostGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	cloudInstanceID, hostGroupID, err := splitID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	client := instance.NewIBMPIHostGroupsClient(ctx, sess, cloudInstanceID)
 	hostGroup, err := client.GetHostGroup(hostGroupID)
@@ -196,11 +197,14 @@ func resourceIBMPIHostGroupRead(ctx context.Context, d *schema.ResourceData, met
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
+	d.Set(Arg_CloudInstanceID, cloudInstanceID)
+	d.Set(Arg_Name, hostGroup.Name)
 	d.Set(Attr_CreationDate, hostGroup.CreationDate.String())
 	d.Set(Attr_HostGroupID, hostGroup.ID)
 	d.Set(Attr_Hosts, hostGroup.Hosts)
+	d.Set(Attr_Name, hostGroup.Name)
 	d.Set(Attr_Primary, hostGroup.Primary)
 	d.Set(Attr_Secondaries, hostGroup.Secondaries)
 
@@ -210,43 +214,51 @@ func resourceIBMPIHostGroupRead(ctx context.Context, d *schema.ResourceData, met
 func resourceIBMPIHostGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	cloudInstanceID, hostGroupID, err := splitID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	client := instance.NewIBMPIHostGroupsClient(ctx, sess, cloudInstanceID)
-	hostGroupUpdateBody := models.HostGroupShareOp{}
-	hasChange := false
+
+	// the share operation stops sharing with one workspace at a time
+	var remove []string
 	if d.HasChange(Arg_Remove) {
-		hostGroupUpdateBody.Remove = d.Get(Arg_Remove).(string)
-		hasChange = true
+		if ws := d.Get(Arg_Remove).(string); ws != "" {
+			remove = append(remove, ws)
+		}
 	}
-
+	var add []*models.Secondary
 	if d.HasChange(Arg_Secondaries) {
-		oldSecondaries, newSecondaries := d.GetChange(Arg_Secondaries)
-		if len(oldSecondaries.([]interface{})) == len(newSecondaries.([]interface{})) {
-			return diag.FromErr(fmt.Errorf("change in place not supported for: %v", Arg_Secondaries))
+		oldRaw, newRaw := d.GetChange(Arg_Secondaries)
+		oldSecondaries, newSecondaries := oldRaw.(*schema.Set), newRaw.(*schema.Set)
+		for _, v := range oldSecondaries.Difference(newSecondaries).List() {
+			remove = append(remove, v.(map[string]interface{})[Attr_Workspace].(string))
 		}
-		var add []*models.Secondary
-		for _, v := range d.Get(Arg_Secondaries).([]interface{}) {
-			secData := v.(map[string]interface{})
-			addItem := secondaryMapToSecondary(secData)
-			add = append(add, addItem)
+		for _, v := range newSecondaries.Difference(oldSecondaries).List() {
+			add = append(add, secondaryMapToSecondary(v.(map[string]interface{})))
 		}
-		hostGroupUpdateBody.Add = add
-		hasChange = true
 	}
 
-	if hasChange {
-		_, err := client.UpdateHostGroup(&hostGroupUpdateBody, hostGroupID)
+	for _, ws := range remove {
+		_, err := client.UpdateHostGroup(&models.HostGroupShareOp{Remove: ws}, hostGroupID)
+		if err != nil {
+			if strings.Contains(err.Error(), NotFound) {
+				d.SetId("")
+				return nil
+			}
+			return piDiagFromErr(fmt.Errorf("error unsharing host group %s with workspace %s: %v", hostGroupID, ws, err))
+		}
+	}
+	if len(add) > 0 {
+		_, err := client.UpdateHostGroup(&models.HostGroupShareOp{Add: add}, hostGroupID)
 		if err != nil {
 			if strings.Contains(err.Error(), NotFound) {
 				d.SetId("")
 				return nil
 			}
-			return diag.FromErr(err)
+			return piDiagFromErr(err)
 		}
 	}
 
@@ -256,11 +268,11 @@ func resourceIBMPIHostGroupUpdate(ctx context.Context, d *schema.ResourceData, m
 func resourceIBMPIHostGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	cloudInstanceID, hostGroupID, err := splitID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	client := instance.NewIBMPIHostGroupsClient(ctx, sess, cloudInstanceID)
 	hostGroup, err := client.GetHostGroup(hostGroupID)
@@ -269,7 +281,7 @@ func resourceIBMPIHostGroupDelete(ctx context.Context, d *schema.ResourceData, m
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	for _, v := range hostGroup.Hosts {
 		ref, err := json.Marshal(v)
@@ -279,20 +291,20 @@ func resourceIBMPIHostGroupDelete(ctx context.Context, d *schema.ResourceData, m
 		hostRef := string(ref)
 		hostID, err := getLastPart(hostRef)
 		if err != nil {
-			return diag.FromErr(err)
+			return piDiagFromErr(err)
 		}
 		err = client.DeleteHost(hostID)
 		if err != nil {
-			return diag.FromErr(err)
+			return piDiagFromErr(err)
 		}
 		_, err = isWaitForHostDeleted(ctx, client, hostID, d.Timeout(schema.TimeoutDelete))
 		if err != nil {
-			return diag.FromErr(err)
+			return piDiagFromErr(err)
 		}
 	}
 	_, err = isWaitForHostGroupDeleted(ctx, client, hostGroupID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	d.SetId("")
 	return nil
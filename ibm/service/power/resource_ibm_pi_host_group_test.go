@@ -34,6 +34,12 @@ func TestAccIBMPIHostGroupBasic(t *testing.T) {
 					resource.TestCheckResourceAttr("ibm_pi_host_group.hostGroup", "pi_name", name),
 				),
 			},
+			{
+				ResourceName:            "ibm_pi_host_group.hostGroup",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"pi_hosts"},
+			},
 		},
 	})
 }
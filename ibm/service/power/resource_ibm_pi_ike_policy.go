@@ -7,15 +7,19 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+
 	st "github.com/IBM-Cloud/power-go-client/clients/instance"
 	"github.com/IBM-Cloud/power-go-client/helpers"
 	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/secretsmanager"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 )
 
@@ -29,6 +33,7 @@ func ResourceIBMPIIKEPolicy() *schema.Resource {
 		ReadContext:   resourceIBMPIIKEPolicyRead,
 		UpdateContext: resourceIBMPIIKEPolicyUpdate,
 		DeleteContext: resourceIBMPIIKEPolicyDelete,
+		CustomizeDiff: resourceIBMPIIKEPolicyCustomizeDiff,
 		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -74,9 +79,17 @@ func ResourceIBMPIIKEPolicy() *schema.Resource {
 				Description:  "Version of the IKE Policy",
 			},
 			helpers.PIVPNPolicyPresharedKey: {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Preshared key used in this IKE Policy (length of preshared key must be even)",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{helpers.PIVPNPolicyPresharedKey, Arg_PolicyPresharedKeySecretCRN},
+				Description:  "Preshared key used in this IKE Policy (length of preshared key must be even)",
+			},
+			Arg_PolicyPresharedKeySecretCRN: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{helpers.PIVPNPolicyPresharedKey, Arg_PolicyPresharedKeySecretCRN},
+				Description:  "CRN of a Secrets Manager arbitrary secret holding the preshared key; resolved at apply time so the key is not stored in the plan or state",
 			},
 
 			// Optional Attributes
@@ -94,6 +107,11 @@ func ResourceIBMPIIKEPolicy() *schema.Resource {
 				Computed:    true,
 				Description: "IKE Policy ID",
 			},
+			Attr_PresharedKeySecretVersions: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of versions of the preshared key secret when the key was applied; a rotation of the secret updates the preshared key",
+			},
 		},
 	}
 }
@@ -101,14 +119,17 @@ func ResourceIBMPIIKEPolicy() *schema.Resource {
 func resourceIBMPIIKEPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(helpers.PICloudInstanceId).(string)
 	name := d.Get(helpers.PIVPNPolicyName).(string)
 	dhGroup := int64(d.Get(helpers.PIVPNPolicyDhGroup).(int))
 	encryption := d.Get(helpers.PIVPNPolicyEncryption).(string)
-	presharedKey := d.Get(helpers.PIVPNPolicyPresharedKey).(string)
+	presharedKey, err := getIKEPolicyPresharedKey(ctx, d, meta)
+	if err != nil {
+		return piDiagFromErr(err)
+	}
 	version := int64(d.Get(helpers.PIVPNPolicyVersion).(int))
 	keyLifetime := int64(d.Get(helpers.PIVPNPolicyKeyLifetime).(int))
 	klt := models.KeyLifetime(keyLifetime)
@@ -130,7 +151,7 @@ func resourceIBMPIIKEPolicyCreate(ctx context.Context, d *schema.ResourceData, m
 	ikePolicy, err := client.CreateIKEPolicy(body)
 	if err != nil {
 		log.Printf("[DEBUG] create ike policy failed %v", err)
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, *ikePolicy.ID))
@@ -141,12 +162,12 @@ func resourceIBMPIIKEPolicyCreate(ctx context.Context, d *schema.ResourceData, m
 func resourceIBMPIIKEPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID, policyID, err := splitID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	client := st.NewIBMPIVpnPolicyClient(ctx, sess, cloudInstanceID)
@@ -168,8 +189,11 @@ func resourceIBMPIIKEPolicyUpdate(ctx context.Context, d *schema.ResourceData, m
 		keyLifetime := int64(d.Get(helpers.PIVPNPolicyKeyLifetime).(int))
 		body.KeyLifetime = models.KeyLifetime(keyLifetime)
 	}
-	if d.HasChange(helpers.PIVPNPolicyPresharedKey) {
-		presharedKey := d.Get(helpers.PIVPNPolicyPresharedKey).(string)
+	if d.HasChanges(helpers.PIVPNPolicyPresharedKey, Arg_PolicyPresharedKeySecretCRN, Attr_PresharedKeySecretVersions) {
+		presharedKey, err := getIKEPolicyPresharedKey(ctx, d, meta)
+		if err != nil {
+			return piDiagFromErr(err)
+		}
 		body.PresharedKey = presharedKey
 	}
 	if d.HasChange(helpers.PIVPNPolicyVersion) {
@@ -183,7 +207,7 @@ func resourceIBMPIIKEPolicyUpdate(ctx context.Context, d *schema.ResourceData, m
 
 	_, err = client.UpdateIKEPolicy(policyID, body)
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	return resourceIBMPIIKEPolicyRead(ctx, d, meta)
@@ -192,12 +216,12 @@ func resourceIBMPIIKEPolicyUpdate(ctx context.Context, d *schema.ResourceData, m
 func resourceIBMPIIKEPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID, policyID, err := splitID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	client := st.NewIBMPIVpnPolicyClient(ctx, sess, cloudInstanceID)
@@ -211,7 +235,7 @@ func resourceIBMPIIKEPolicyRead(ctx context.Context, d *schema.ResourceData, met
 		// 	return nil
 		// }
 		log.Printf("[DEBUG] get VPN policy failed %v", err)
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	d.Set(PIPolicyId, ikePolicy.ID)
@@ -228,12 +252,12 @@ func resourceIBMPIIKEPolicyRead(ctx context.Context, d *schema.ResourceData, met
 func resourceIBMPIIKEPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID, policyID, err := splitID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	client := st.NewIBMPIVpnPolicyClient(ctx, sess, cloudInstanceID)
@@ -248,9 +272,82 @@ func resourceIBMPIIKEPolicyDelete(ctx context.Context, d *schema.ResourceData, m
 		// 	return nil
 		// }
 		log.Printf("[DEBUG] delete VPN policy failed %v", err)
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	d.SetId("")
 	return nil
 }
+
+// getIKEPolicyPresharedKey returns the preshared key set in the configuration or, when a secret CRN
+// is given instead, the payload of that Secrets Manager secret, and records the number of versions
+// of the secret so that a rotation is planned as an update.
+func getIKEPolicyPresharedKey(ctx context.Context, d *schema.ResourceData, meta interface{}) (string, error) {
+	v, ok := d.GetOk(Arg_PolicyPresharedKeySecretCRN)
+	if !ok {
+		d.Set(Attr_PresharedKeySecretVersions, 0)
+		return d.Get(helpers.PIVPNPolicyPresharedKey).(string), nil
+	}
+	client, secretID, err := presharedKeySecretsManagerClient(meta, v.(string))
+	if err != nil {
+		return "", err
+	}
+	secret, response, err := client.GetSecretWithContext(ctx, &secretsmanagerv2.GetSecretOptions{
+		ID: &secretID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("error getting preshared key secret %s: %s with response: %s", v, err, response)
+	}
+	arbitrarySecret, ok := secret.(*secretsmanagerv2.ArbitrarySecret)
+	if !ok || arbitrarySecret.Payload == nil {
+		return "", fmt.Errorf("secret %s is not an arbitrary secret with a payload", v)
+	}
+	if arbitrarySecret.VersionsTotal != nil {
+		d.Set(Attr_PresharedKeySecretVersions, *arbitrarySecret.VersionsTotal)
+	}
+	return *arbitrarySecret.Payload, nil
+}
+
+// resourceIBMPIIKEPolicyCustomizeDiff plans an update of the preshared key when the secret of
+// pi_policy_preshared_key_secret_crn was rotated since the key was applied. The secret is only
+// read when its CRN is unchanged, and a failure to read it is left to the apply.
+func resourceIBMPIIKEPolicyCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	secretCRN := diff.Get(Arg_PolicyPresharedKeySecretCRN).(string)
+	if diff.Id() == "" || secretCRN == "" || diff.HasChange(Arg_PolicyPresharedKeySecretCRN) {
+		return nil
+	}
+	client, secretID, err := presharedKeySecretsManagerClient(meta, secretCRN)
+	if err != nil {
+		return err
+	}
+	metadata, response, err := client.GetSecretMetadataWithContext(ctx, &secretsmanagerv2.GetSecretMetadataOptions{
+		ID: &secretID,
+	})
+	if err != nil {
+		log.Printf("[WARN] failed to check the versions of preshared key secret %s: %s with response: %s", secretCRN, err, response)
+		return nil
+	}
+	arbitraryMetadata, ok := metadata.(*secretsmanagerv2.ArbitrarySecretMetadata)
+	if !ok || arbitraryMetadata.VersionsTotal == nil {
+		return nil
+	}
+	if int64(diff.Get(Attr_PresharedKeySecretVersions).(int)) != *arbitraryMetadata.VersionsTotal {
+		return diff.SetNewComputed(Attr_PresharedKeySecretVersions)
+	}
+	return nil
+}
+
+// presharedKeySecretsManagerClient returns a client for the Secrets Manager instance of the secret
+// identified by secretCRN, crn:v1:<cname>:<ctype>:secrets-manager:<region>:<scope>:<instance-id>:secret:<secret-id>,
+// and the ID of the secret.
+func presharedKeySecretsManagerClient(meta interface{}, secretCRN string) (*secretsmanagerv2.SecretsManagerV2, string, error) {
+	parts := strings.Split(secretCRN, ":")
+	if len(parts) != 10 || parts[0] != "crn" || parts[4] != "secrets-manager" || parts[8] != "secret" || parts[5] == "" || parts[7] == "" || parts[9] == "" {
+		return nil, "", fmt.Errorf("%q is not a valid Secrets Manager secret CRN", secretCRN)
+	}
+	smClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return nil, "", err
+	}
+	return secretsmanager.GetClientWithInstanceEndpoint(smClient, parts[7], parts[5]), parts[9], nil
+}
@@ -7,9 +7,17 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
+	"strings"
 	"time"
 
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials"
+	"github.com/IBM/ibm-cos-sdk-go/aws/session"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -80,20 +88,29 @@ func ResourceIBMPIImage() *schema.Resource {
 				ForceNew:      true,
 			},
 			helpers.PIImageAccessKey: {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "Cloud Object Storage access key; required for buckets with private access",
-				ForceNew:     true,
-				Sensitive:    true,
-				RequiredWith: []string{helpers.PIImageSecretKey},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Cloud Object Storage access key; required for buckets with private access",
+				ForceNew:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{Arg_COSResourceKeyID},
+				RequiredWith:  []string{helpers.PIImageSecretKey},
+			},
+			Arg_COSResourceKeyID: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "ID or CRN of a Cloud Object Storage resource key with HMAC credentials; used to resolve the access and secret keys for buckets with private access",
+				ConflictsWith: []string{helpers.PIImageId, helpers.PIImageAccessKey, helpers.PIImageSecretKey},
+				ForceNew:      true,
 			},
 			helpers.PIImageSecretKey: {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "Cloud Object Storage secret key; required for buckets with private access",
-				ForceNew:     true,
-				Sensitive:    true,
-				RequiredWith: []string{helpers.PIImageAccessKey},
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Cloud Object Storage secret key; required for buckets with private access",
+				ForceNew:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{Arg_COSResourceKeyID},
+				RequiredWith:  []string{helpers.PIImageAccessKey},
 			},
 			helpers.PIImageBucketRegion: {
 				Type:          schema.TypeString,
@@ -111,6 +128,15 @@ func ResourceIBMPIImage() *schema.Resource {
 				RequiredWith:  []string{helpers.PIImageBucketName},
 				ForceNew:      true,
 			},
+			Arg_ImageOSType: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Operating system contained in the image; required for BYOI imports of IBM i images",
+				ValidateFunc:  validate.ValidateAllowedStringValues([]string{models.CreateCosImageImportJobOsTypeAix, models.CreateCosImageImportJobOsTypeIbmi, models.CreateCosImageImportJobOsTypeRhel, models.CreateCosImageImportJobOsTypeSles}),
+				ConflictsWith: []string{helpers.PIImageId},
+				RequiredWith:  []string{helpers.PIImageBucketName},
+				ForceNew:      true,
+			},
 			helpers.PIImageStorageType: {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -123,41 +149,41 @@ func ResourceIBMPIImage() *schema.Resource {
 				Description: "Storage pool where the image will be loaded, if provided then pi_affinity_policy will be ignored",
 				ForceNew:    true,
 			},
-			PIAffinityPolicy: {
+			Arg_AffinityPolicy: {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Description:  "Affinity policy for image; ignored if pi_image_storage_pool provided; for policy affinity requires one of pi_affinity_instance or pi_affinity_volume to be specified; for policy anti-affinity requires one of pi_anti_affinity_instances or pi_anti_affinity_volumes to be specified",
 				ValidateFunc: validate.ValidateAllowedStringValues([]string{"affinity", "anti-affinity"}),
 				ForceNew:     true,
 			},
-			PIAffinityVolume: {
+			Arg_AffinityVolume: {
 				Type:          schema.TypeString,
 				Optional:      true,
 				Description:   "Volume (ID or Name) to base storage affinity policy against; required if requesting affinity and pi_affinity_instance is not provided",
-				ConflictsWith: []string{PIAffinityInstance},
+				ConflictsWith: []string{Arg_AffinityInstance},
 				ForceNew:      true,
 			},
-			PIAffinityInstance: {
+			Arg_AffinityInstance: {
 				Type:          schema.TypeString,
 				Optional:      true,
 				Description:   "PVM Instance (ID or Name) to base storage affinity policy against; required if requesting storage affinity and pi_affinity_volume is not provided",
-				ConflictsWith: []string{PIAffinityVolume},
+				ConflictsWith: []string{Arg_AffinityVolume},
 				ForceNew:      true,
 			},
-			PIAntiAffinityVolumes: {
+			Arg_AntiAffinityVolumes: {
 				Type:          schema.TypeList,
 				Optional:      true,
 				Elem:          &schema.Schema{Type: schema.TypeString},
 				Description:   "List of volumes to base storage anti-affinity policy against; required if requesting anti-affinity and pi_anti_affinity_instances is not provided",
-				ConflictsWith: []string{PIAntiAffinityInstances},
+				ConflictsWith: []string{Arg_AntiAffinityInstances},
 				ForceNew:      true,
 			},
-			PIAntiAffinityInstances: {
+			Arg_AntiAffinityInstances: {
 				Type:          schema.TypeList,
 				Optional:      true,
 				Elem:          &schema.Schema{Type: schema.TypeString},
 				Description:   "List of pvmInstances to base storage anti-affinity policy against; required if requesting anti-affinity and pi_anti_affinity_volumes is not provided",
-				ConflictsWith: []string{PIAntiAffinityVolumes},
+				ConflictsWith: []string{Arg_AntiAffinityVolumes},
 				ForceNew:      true,
 			},
 			Arg_ImageImportDetails: {
@@ -195,6 +221,16 @@ func ResourceIBMPIImage() *schema.Resource {
 				Computed:    true,
 				Description: "Image ID",
 			},
+			Attr_JobID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the last job run for the image import",
+			},
+			Attr_JobStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of the last job run for the image import, for example completed or failed",
+			},
 		},
 	}
 }
@@ -203,7 +239,7 @@ func resourceIBMPIImageCreate(ctx context.Context, d *schema.ResourceData, meta
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
 		log.Printf("Failed to get the session")
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(helpers.PICloudInstanceId).(string)
@@ -213,6 +249,9 @@ func resourceIBMPIImageCreate(ctx context.Context, d *schema.ResourceData, meta
 	// image copy
 	if v, ok := d.GetOk(helpers.PIImageId); ok {
 		imageid := v.(string)
+		if err := validateIBMPIImageCopySource(client, cloudInstanceID, imageid); err != nil {
+			return piDiagFromErr(err)
+		}
 		source := "root-project"
 		var body = &models.CreateImage{
 			ImageName: imageName,
@@ -221,7 +260,7 @@ func resourceIBMPIImageCreate(ctx context.Context, d *schema.ResourceData, meta
 		}
 		imageResponse, err := client.Create(body)
 		if err != nil {
-			return diag.FromErr(err)
+			return piDiagFromErr(err)
 		}
 
 		IBMPIImageID := imageResponse.ImageID
@@ -230,7 +269,7 @@ func resourceIBMPIImageCreate(ctx context.Context, d *schema.ResourceData, meta
 		_, err = isWaitForIBMPIImageAvailable(ctx, client, *IBMPIImageID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			log.Printf("[DEBUG]  err %s", err)
-			return diag.FromErr(err)
+			return piDiagFromErr(err)
 		}
 	}
 
@@ -254,34 +293,50 @@ func resourceIBMPIImageCreate(ctx context.Context, d *schema.ResourceData, meta
 		if v, ok := d.GetOk(helpers.PIImageSecretKey); ok {
 			body.SecretKey = v.(string)
 		}
+		if v, ok := d.GetOk(Arg_COSResourceKeyID); ok {
+			accessKey, secretKey, err := cosHMACKeysFromResourceKey(meta, v.(string))
+			if err != nil {
+				return piDiagFromErr(err)
+			}
+			body.AccessKey = accessKey
+			body.SecretKey = secretKey
+		}
+
+		endpoint := fmt.Sprintf("https://s3.%s.cloud-object-storage.appdomain.cloud", bucketRegion)
+		if err := checkIBMPIImageBucketObject(endpoint, bucketName, bucketImageFileName, body.AccessKey, body.SecretKey); err != nil {
+			return piDiagFromErr(err)
+		}
 
+		if v, ok := d.GetOk(Arg_ImageOSType); ok {
+			body.OsType = v.(string)
+		}
 		if v, ok := d.GetOk(helpers.PIImageStorageType); ok {
 			body.StorageType = v.(string)
 		}
 		if v, ok := d.GetOk(helpers.PIImageStoragePool); ok {
 			body.StoragePool = v.(string)
 		}
-		if ap, ok := d.GetOk(PIAffinityPolicy); ok {
+		if ap, ok := d.GetOk(Arg_AffinityPolicy); ok {
 			policy := ap.(string)
 			affinity := &models.StorageAffinity{
 				AffinityPolicy: &policy,
 			}
 
 			if policy == "affinity" {
-				if av, ok := d.GetOk(PIAffinityVolume); ok {
+				if av, ok := d.GetOk(Arg_AffinityVolume); ok {
 					afvol := av.(string)
 					affinity.AffinityVolume = &afvol
 				}
-				if ai, ok := d.GetOk(PIAffinityInstance); ok {
+				if ai, ok := d.GetOk(Arg_AffinityInstance); ok {
 					afins := ai.(string)
 					affinity.AffinityPVMInstance = &afins
 				}
 			} else {
-				if avs, ok := d.GetOk(PIAntiAffinityVolumes); ok {
+				if avs, ok := d.GetOk(Arg_AntiAffinityVolumes); ok {
 					afvols := flex.ExpandStringList(avs.([]interface{}))
 					affinity.AntiAffinityVolumes = afvols
 				}
-				if ais, ok := d.GetOk(PIAntiAffinityInstances); ok {
+				if ais, ok := d.GetOk(Arg_AntiAffinityInstances); ok {
 					afinss := flex.ExpandStringList(ais.([]interface{}))
 					affinity.AntiAffinityPVMInstances = afinss
 				}
@@ -299,19 +354,22 @@ func resourceIBMPIImageCreate(ctx context.Context, d *schema.ResourceData, meta
 		}
 		imageResponse, err := client.CreateCosImage(body)
 		if err != nil {
-			return diag.FromErr(err)
+			return piDiagFromErr(err)
 		}
 
+		// The image ID is only known once the job is completed, so the image is tracked by name
+		// until then, and the job is kept in the state when the import fails
+		d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, imageName))
 		jobClient := st.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
-		_, err = waitForIBMPIJobCompleted(ctx, jobClient, *imageResponse.ID, d.Timeout(schema.TimeoutCreate))
+		err = waitForIBMPIJobRecorded(ctx, d, jobClient, *imageResponse.ID, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
-			return diag.FromErr(err)
+			return piDiagFromErr(err)
 		}
 
 		// Once the job is completed find by name
 		image, err := client.Get(imageName)
 		if err != nil {
-			return diag.FromErr(err)
+			return piDiagFromErr(err)
 		}
 		d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, *image.ImageID))
 	}
@@ -319,15 +377,31 @@ func resourceIBMPIImageCreate(ctx context.Context, d *schema.ResourceData, meta
 	return resourceIBMPIImageRead(ctx, d, meta)
 }
 
+// validateIBMPIImageCopySource checks that the image to copy is a stock image before the copy is
+// started, so that an image of a workspace fails with a clear error instead of a failed copy job.
+func validateIBMPIImageCopySource(client *st.IBMPIImageClient, cloudInstanceID, imageID string) error {
+	_, err := client.GetStockImage(imageID)
+	if err == nil {
+		return nil
+	}
+	if _, ok := errors.Unwrap(err).(*p_cloud_images.PcloudCloudinstancesStockimagesGetNotFound); !ok {
+		return fmt.Errorf("error checking source image %s in the stock image catalog: %v", imageID, err)
+	}
+	if image, err := client.Get(imageID); err == nil && image != nil {
+		return fmt.Errorf("image %s is not a stock image: it already belongs to workspace %s, use it directly instead of copying it", imageID, cloudInstanceID)
+	}
+	return fmt.Errorf("image %s is not a stock image and does not belong to workspace %s: images of other workspaces cannot be copied, export them to Cloud Object Storage and import them with %s instead", imageID, cloudInstanceID, helpers.PIImageBucketName)
+}
+
 func resourceIBMPIImageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID, imageID, err := splitID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	imageC := st.NewIBMPIImageClient(ctx, sess, cloudInstanceID)
@@ -341,7 +415,7 @@ func resourceIBMPIImageRead(ctx context.Context, d *schema.ResourceData, meta in
 			return nil
 		}
 		log.Printf("[DEBUG] get image failed %v", err)
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	imageid := *imagedata.ImageID
@@ -354,18 +428,18 @@ func resourceIBMPIImageRead(ctx context.Context, d *schema.ResourceData, meta in
 func resourceIBMPIImageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID, imageID, err := splitID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	imageC := st.NewIBMPIImageClient(ctx, sess, cloudInstanceID)
 	err = imageC.Delete(imageID)
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	d.SetId("")
@@ -430,3 +504,96 @@ func waitForIBMPIJobCompleted(ctx context.Context, client *st.IBMPIJobClient, jo
 	}
 	return stateConf.WaitForStateContext(ctx)
 }
+
+// waitForIBMPIJobRecorded waits for a job like waitForIBMPIJobCompleted and records the job ID and
+// its final state in the job_id and job_status attributes, so a failed apply can be investigated
+// with support tooling.
+func waitForIBMPIJobRecorded(ctx context.Context, d *schema.ResourceData, client *st.IBMPIJobClient, jobID string, timeout time.Duration) error {
+	d.Set(Attr_JobID, jobID)
+	_, err := waitForIBMPIJobCompleted(ctx, client, jobID, timeout)
+	if err != nil {
+		state := helpers.JobStatusFailed
+		if job, getErr := client.Get(jobID); getErr == nil && job != nil && job.Status != nil && job.Status.State != nil {
+			state = *job.Status.State
+		}
+		d.Set(Attr_JobStatus, state)
+		return fmt.Errorf("job %s ended in state %s: %w", jobID, state, err)
+	}
+	d.Set(Attr_JobStatus, helpers.JobStatusCompleted)
+	return nil
+}
+
+// checkIBMPIImageBucketObject checks that the image file exists in the bucket, with a HEAD request
+// sent with the credentials of the import, so that a typo in the bucket, folder or file name fails
+// before the import job is created instead of when the job fails. The bucket name can include a
+// folder, bucket-name[/optional/folder]. Errors other than a missing object or a denied anonymous
+// access are only logged, and left to the import job: the credentials of a private bucket can be
+// allowed to import the object without being allowed the HEAD request.
+func checkIBMPIImageBucketObject(endpoint, bucketName, fileName, accessKey, secretKey string) error {
+	bucket, folder, _ := strings.Cut(bucketName, "/")
+	key := fileName
+	if folder = strings.Trim(folder, "/"); folder != "" {
+		key = folder + "/" + fileName
+	}
+
+	creds := credentials.AnonymousCredentials
+	if accessKey != "" {
+		creds = credentials.NewStaticCredentials(accessKey, secretKey, "")
+	}
+	sess, err := session.NewSession(aws.NewConfig().WithEndpoint(endpoint).WithRegion("us-standard").WithCredentials(creds).WithS3ForcePathStyle(true))
+	if err != nil {
+		return err
+	}
+	_, err = s3.New(sess).HeadObject(&s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	reqErr, ok := err.(awserr.RequestFailure)
+	if err == nil || !ok {
+		if err != nil {
+			log.Printf("[WARN] failed to check that object %s exists in bucket %s: %v", key, bucket, err)
+		}
+		return nil
+	}
+	switch reqErr.StatusCode() {
+	case http.StatusNotFound:
+		return fmt.Errorf("object %s was not found in bucket %s at %s; check %s and %s", key, bucket, endpoint, helpers.PIImageBucketName, helpers.PIImageBucketFileName)
+	case http.StatusForbidden:
+		if accessKey == "" {
+			return fmt.Errorf("access to object %s in bucket %s at %s was denied; the bucket is not public, set %s to private and pass its credentials", key, bucket, endpoint, helpers.PIImageBucketAccess)
+		}
+	}
+	log.Printf("[WARN] failed to check that object %s exists in bucket %s: %v", key, bucket, err)
+	return nil
+}
+
+// cosHMACKeysFromResourceKey resolves the HMAC access and secret keys of a Cloud Object Storage
+// resource key, identified by its ID or CRN, so they do not have to be passed in the configuration.
+func cosHMACKeysFromResourceKey(meta interface{}, resourceKeyID string) (string, string, error) {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return "", "", err
+	}
+	resourceKey, resp, err := rsConClient.GetResourceKey(&rc.GetResourceKeyOptions{
+		ID: &resourceKeyID,
+	})
+	if err != nil || resourceKey == nil {
+		return "", "", fmt.Errorf("error retrieving resource key %s: %s with resp: %s", resourceKeyID, err, resp)
+	}
+	if resourceKey.Credentials == nil {
+		return "", "", fmt.Errorf("resource key %s has no credentials", resourceKeyID)
+	}
+	if resourceKey.Credentials.Redacted != nil {
+		return "", "", fmt.Errorf("credentials of resource key %s are redacted (%s); the user does not have access to view them", resourceKeyID, *resourceKey.Credentials.Redacted)
+	}
+	hmacKeys, ok := resourceKey.Credentials.GetProperty("cos_hmac_keys").(map[string]interface{})
+	if !ok {
+		return "", "", fmt.Errorf("resource key %s does not contain HMAC credentials; create it with the parameter {\"HMAC\": true}", resourceKeyID)
+	}
+	accessKey, _ := hmacKeys["access_key_id"].(string)
+	secretKey, _ := hmacKeys["secret_access_key"].(string)
+	if accessKey == "" || secretKey == "" {
+		return "", "", fmt.Errorf("resource key %s has incomplete HMAC credentials", resourceKeyID)
+	}
+	return accessKey, secretKey, nil
+}
@@ -72,6 +72,34 @@ func ResourceIBMPIImageExport() *schema.Resource {
 				ForceNew:    true,
 				Required:    true,
 			},
+			Arg_Checksum: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Indicates if a checksum file is created next to the exported image",
+			},
+
+			// Computed attributes
+			Attr_ImageFileName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the exported image object in the bucket",
+			},
+			Attr_ImageName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the exported image",
+			},
+			Attr_JobID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the image export job",
+			},
+			Attr_JobStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of the image export job, for example completed or failed",
+			},
 		},
 	}
 }
@@ -80,7 +108,7 @@ func resourceIBMPIImageExportCreate(ctx context.Context, d *schema.ResourceData,
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
 		log.Printf("Failed to get the session")
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(helpers.PICloudInstanceId).(string)
@@ -89,25 +117,35 @@ func resourceIBMPIImageExportCreate(ctx context.Context, d *schema.ResourceData,
 	accessKey := d.Get(helpers.PIImageAccessKey).(string)
 
 	client := st.NewIBMPIImageClient(ctx, sess, cloudInstanceID)
+	image, err := client.Get(imageid)
+	if err != nil {
+		return piDiagFromErr(err)
+	}
 
 	// image export
 	var body = &models.ExportImage{
 		BucketName: &bucketName,
 		AccessKey:  &accessKey,
+		Checksum:   d.Get(Arg_Checksum).(bool),
 		Region:     d.Get(helpers.PIImageBucketRegion).(string),
 		SecretKey:  d.Get(helpers.PIImageSecretKey).(string),
 	}
 
 	imageResponse, err := client.ExportImage(imageid, body)
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	d.SetId(fmt.Sprintf("%s/%s/%s", imageid, bucketName, d.Get(helpers.PIImageBucketRegion).(string)))
+	if image.Name != nil {
+		// The image is exported as a compressed OVA named after the image
+		d.Set(Attr_ImageFileName, *image.Name+".ova.gz")
+		d.Set(Attr_ImageName, *image.Name)
+	}
 
 	jobClient := st.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
-	_, err = waitForIBMPIJobCompleted(ctx, jobClient, *imageResponse.ID, d.Timeout(schema.TimeoutCreate))
+	err = waitForIBMPIJobRecorded(ctx, d, jobClient, *imageResponse.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	return nil
 }
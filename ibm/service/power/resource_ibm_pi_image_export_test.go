@@ -20,6 +20,8 @@ func TestAccIBMPIImageEport(t *testing.T) {
 				Config: testAccCheckIBMPIImageExportConfig(),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("ibm_pi_image_export.power_image_export", "id"),
+					resource.TestCheckResourceAttrSet("ibm_pi_image_export.power_image_export", "job_id"),
+					resource.TestCheckResourceAttrSet("ibm_pi_image_export.power_image_export", "image_file_name"),
 				),
 			},
 		},
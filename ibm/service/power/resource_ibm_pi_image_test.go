@@ -176,3 +176,41 @@ func testAccCheckIBMPIImageBYOLConfig(name string) string {
 	}
 	`, name, acc.Pi_cloud_instance_id, acc.Pi_image_bucket_name, acc.Pi_image_bucket_file_name, acc.Pi_image_bucket_access_key, acc.Pi_image_bucket_secret_key)
 }
+
+func TestAccIBMPIImageIBMiBYOIImport(t *testing.T) {
+	imageRes := "ibm_pi_image.cos_image"
+	name := fmt.Sprintf("tf-pi-image-ibmi-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIImageIBMiBYOIConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIImageExists(imageRes),
+					resource.TestCheckResourceAttr(imageRes, "pi_image_name", name),
+					resource.TestCheckResourceAttr(imageRes, "pi_image_os_type", "ibmi"),
+					resource.TestCheckResourceAttrSet(imageRes, "image_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIImageIBMiBYOIConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_pi_image" "cos_image" {
+		pi_cloud_instance_id = "%[2]s"
+		pi_image_access_key = "%[5]s"
+		pi_image_bucket_access = "private"
+		pi_image_bucket_file_name = "%[4]s"
+		pi_image_bucket_name = "%[3]s"
+		pi_image_bucket_region = "us-east"
+		pi_image_name       = "%[1]s"
+		pi_image_os_type = "ibmi"
+		pi_image_secret_key = "%[6]s"
+		pi_image_storage_type = "tier3"
+	}
+	`, name, acc.Pi_cloud_instance_id, acc.Pi_image_bucket_name, acc.Pi_image_bucket_file_name, acc.Pi_image_bucket_access_key, acc.Pi_image_bucket_secret_key)
+}
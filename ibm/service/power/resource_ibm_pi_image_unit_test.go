@@ -0,0 +1,63 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCheckIBMPIImageBucketObject(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		switch {
+		case r.Method != http.MethodHead:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		case strings.HasPrefix(r.URL.Path, "/private/"):
+			if r.Header.Get("Authorization") == "" {
+				w.WriteHeader(http.StatusForbidden)
+			}
+		case strings.HasPrefix(r.URL.Path, "/import-only/"):
+			w.WriteHeader(http.StatusForbidden)
+		case r.URL.Path == "/images/aix/aix-7300.ova.gz":
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name      string
+		bucket    string
+		accessKey string
+		wantPath  string
+		wantErr   string
+	}{
+		{name: "folder", bucket: "images/aix", wantPath: "/images/aix/aix-7300.ova.gz"},
+		{name: "folder with slashes", bucket: "images//aix/", wantPath: "/images/aix/aix-7300.ova.gz"},
+		{name: "missing folder", bucket: "images", wantPath: "/images/aix-7300.ova.gz", wantErr: "object aix-7300.ova.gz was not found in bucket images"},
+		{name: "not public", bucket: "private", wantPath: "/private/aix-7300.ova.gz", wantErr: "the bucket is not public"},
+		{name: "credentials", bucket: "private", accessKey: "access", wantPath: "/private/aix-7300.ova.gz"},
+		{name: "credentials without head access", bucket: "import-only", accessKey: "access", wantPath: "/import-only/aix-7300.ova.gz"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			paths = nil
+			err := checkIBMPIImageBucketObject(server.URL, tc.bucket, "aix-7300.ova.gz", tc.accessKey, "secret")
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Errorf("error = %v, want an error containing %q", err, tc.wantErr)
+				}
+			} else if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if len(paths) == 0 || paths[0] != tc.wantPath {
+				t.Errorf("paths = %q, want %q", paths, tc.wantPath)
+			}
+		})
+	}
+}
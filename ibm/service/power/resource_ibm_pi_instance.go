@@ -4,10 +4,16 @@
 package power
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"mime/multipart"
+	"net/textproto"
+	"slices"
 	"strings"
 	"time"
 
@@ -18,12 +24,18 @@ import (
 
 	st "github.com/IBM-Cloud/power-go-client/clients/instance"
 	"github.com/IBM-Cloud/power-go-client/helpers"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_p_vm_instances"
 	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 )
 
+// piInstanceWaitDelay returns the delay before the first refresh of the waiters of instance
+// updates. It is a variable so that unit tests against the mock API do not wait.
+var piInstanceWaitDelay = func(delay time.Duration) time.Duration { return delay }
+
 func ResourceIBMPIInstance() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceIBMPIInstanceCreate,
@@ -104,37 +116,37 @@ func ResourceIBMPIInstance() *schema.Resource {
 				Computed:    true,
 				Description: "Storage Pool for server deployment; if provided then pi_storage_pool_affinity will be ignored; Only valid when you deploy one of the IBM supplied stock images. Storage pool for a custom image (an imported image or an image that is created from a VM capture) defaults to the storage pool the image was created in",
 			},
-			PIAffinityPolicy: {
+			Arg_AffinityPolicy: {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Description:  "Affinity policy for pvm instance being created; ignored if pi_storage_pool provided; for policy affinity requires one of pi_affinity_instance or pi_affinity_volume to be specified; for policy anti-affinity requires one of pi_anti_affinity_instances or pi_anti_affinity_volumes to be specified",
 				ValidateFunc: validate.ValidateAllowedStringValues([]string{"affinity", "anti-affinity"}),
 			},
-			PIAffinityVolume: {
+			Arg_AffinityVolume: {
 				Type:          schema.TypeString,
 				Optional:      true,
 				Description:   "Volume (ID or Name) to base storage affinity policy against; required if requesting affinity and pi_affinity_instance is not provided",
-				ConflictsWith: []string{PIAffinityInstance},
+				ConflictsWith: []string{Arg_AffinityInstance},
 			},
-			PIAffinityInstance: {
+			Arg_AffinityInstance: {
 				Type:          schema.TypeString,
 				Optional:      true,
 				Description:   "PVM Instance (ID or Name) to base storage affinity policy against; required if requesting storage affinity and pi_affinity_volume is not provided",
-				ConflictsWith: []string{PIAffinityVolume},
+				ConflictsWith: []string{Arg_AffinityVolume},
 			},
-			PIAntiAffinityVolumes: {
+			Arg_AntiAffinityVolumes: {
 				Type:          schema.TypeList,
 				Optional:      true,
 				Elem:          &schema.Schema{Type: schema.TypeString},
 				Description:   "List of volumes to base storage anti-affinity policy against; required if requesting anti-affinity and pi_anti_affinity_instances is not provided",
-				ConflictsWith: []string{PIAntiAffinityInstances},
+				ConflictsWith: []string{Arg_AntiAffinityInstances},
 			},
-			PIAntiAffinityInstances: {
+			Arg_AntiAffinityInstances: {
 				Type:          schema.TypeList,
 				Optional:      true,
 				Elem:          &schema.Schema{Type: schema.TypeString},
 				Description:   "List of pvmInstances to base storage anti-affinity policy against; required if requesting anti-affinity and pi_anti_affinity_volumes is not provided",
-				ConflictsWith: []string{PIAntiAffinityVolumes},
+				ConflictsWith: []string{Arg_AntiAffinityVolumes},
 			},
 			helpers.PIInstanceStorageConnection: {
 				Type:         schema.TypeString,
@@ -148,8 +160,16 @@ func ResourceIBMPIInstance() *schema.Resource {
 				Default:     true,
 				Description: "Indicates if all volumes attached to the server must reside in the same storage pool",
 			},
+			Arg_IgnoreStoragePoolAffinityDrift: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates if changes of the storage pool affinity made outside of Terraform are ignored instead of being reported as drift",
+			},
+			Arg_PowerSchedule: powerScheduleSchema(false),
 			Arg_DeploymentTarget: {
 				Description: "The deployment of a dedicated host.",
+				ForceNew:    true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						Attr_ID: {
@@ -170,10 +190,9 @@ func ResourceIBMPIInstance() *schema.Resource {
 				Type:     schema.TypeSet,
 			},
 			PIInstanceNetwork: {
-				Type:             schema.TypeList,
-				DiffSuppressFunc: flex.ApplyOnce,
-				Required:         true,
-				Description:      "List of one or more networks to attach to the instance",
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "List of one or more networks to attach to the instance",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"ip_address": {
@@ -226,6 +245,35 @@ func ResourceIBMPIInstance() *schema.Resource {
 				Computed:    true,
 				Description: "PI Instance health status",
 			},
+			Attr_HostID: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The ID of the host the instance is placed on, when exposed by the API",
+			},
+			Attr_PlacementGroupMembers: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The instances of the placement group and the hosts they are placed on",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_HostID: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ID of the host the instance is placed on, or 0 when it is not exposed by the API",
+						},
+						Attr_PVMInstanceID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the instance",
+						},
+					},
+				},
+			},
+			Attr_PlacementSatisfied: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the hosts of the placement group members were confirmed to honor its affinity or anti-affinity policy",
+			},
 			"instance_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -263,10 +311,20 @@ func ResourceIBMPIInstance() *schema.Resource {
 				Description:   "Instance processor type",
 			},
 			helpers.PIInstanceSSHKeyName: {
-				Type:        schema.TypeString,
-				ForceNew:    true,
-				Optional:    true,
-				Description: "SSH key name",
+				Type:          schema.TypeString,
+				ForceNew:      true,
+				Optional:      true,
+				ConflictsWith: []string{Arg_SSHKeys},
+				Description:   "SSH key name",
+			},
+			Arg_SSHKeys: {
+				Type:          schema.TypeList,
+				ForceNew:      true,
+				Optional:      true,
+				MinItems:      1,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{helpers.PIInstanceSSHKeyName},
+				Description:   "SSH key names. The first key is the key pair of the instance, the public keys of the others are added to the authorized keys of the cloud-init user data",
 			},
 			helpers.PIInstanceMemory: {
 				Type:          schema.TypeFloat,
@@ -297,7 +355,7 @@ func ResourceIBMPIInstance() *schema.Resource {
 			PIVirtualOpticalDevice: {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validate.ValidateAllowedStringValues([]string{"attach"}),
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{models.CloudInitializationVirtualOpticalDeviceAttach, models.CloudInitializationVirtualOpticalDeviceDetach}),
 				Description:  "Virtual Machine's Cloud Initialization Virtual Optical Device",
 			},
 			helpers.PIInstanceSystemType: {
@@ -352,12 +410,35 @@ func ResourceIBMPIInstance() *schema.Resource {
 				Computed:    true,
 				Description: "OS Type",
 			},
-			helpers.PIInstanceHealthStatus: {
+			Arg_PVMInstanceHealthStatus: {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validate.ValidateAllowedStringValues([]string{helpers.PIInstanceHealthOk, helpers.PIInstanceHealthWarning}),
-				Default:      "OK",
-				Description:  "Allow the user to set the status of the lpar so that they can connect to it faster",
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{Health_OK, Health_Warning}),
+				Default:      Health_OK,
+				Description:  "The health status to wait for before the instance is considered ready, OK or WARNING. Waiting for WARNING lets the user connect to the lpar faster",
+			},
+			Arg_NetworkHealthCheck: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicates if the create waits for every attached network interface to have an IP address and an ACTIVE port before the instance is considered ready",
+			},
+			Arg_DeleteDataVolumes: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Indicates if the data volumes attached to the instance are deleted with it. The boot volume is always deleted",
+			},
+			Arg_ShutdownOnDelete: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"none", "stop", "immediate-shutdown"}),
+				Description:  "How the instance is shut down before it is deleted: none, stop for a soft shutdown of the operating system or immediate-shutdown",
+			},
+			Arg_DetachVirtualOpticalDevice: {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				RequiredWith: []string{PIVirtualOpticalDevice},
+				Description:  "Indicates if the cloud initialization virtual optical device attached at create is detached once the instance booted with it; the instance is restarted to boot with the device",
 			},
 			helpers.PIVirtualCoresAssigned: {
 				Type:        schema.TypeInt,
@@ -410,25 +491,30 @@ func resourceIBMPIInstanceCreate(ctx context.Context, d *schema.ResourceData, me
 	log.Printf("Now in the PowerVMCreate")
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	cloudInstanceID := d.Get(helpers.PICloudInstanceId).(string)
 	client := st.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
 	sapClient := st.NewIBMPISAPInstanceClient(ctx, sess, cloudInstanceID)
 	imageClient := st.NewIBMPIImageClient(ctx, sess, cloudInstanceID)
 
+	userData, err := ibmPIInstanceUserData(d, st.NewIBMPIKeyClient(ctx, sess, cloudInstanceID))
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+
 	var pvmList *models.PVMInstanceList
 	if _, ok := d.GetOk(PISAPInstanceProfileID); ok {
-		pvmList, err = createSAPInstance(d, sapClient)
+		pvmList, err = createSAPInstance(d, sapClient, userData)
 	} else {
-		pvmList, err = createPVMInstance(d, client, imageClient)
+		pvmList, err = createPVMInstance(d, client, imageClient, userData)
 	}
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	var instanceReadyStatus string
-	if r, ok := d.GetOk(helpers.PIInstanceHealthStatus); ok {
+	if r, ok := d.GetOk(Arg_PVMInstanceHealthStatus); ok {
 		instanceReadyStatus = r.(string)
 	}
 
@@ -440,47 +526,59 @@ func resourceIBMPIInstanceCreate(ctx context.Context, d *schema.ResourceData, me
 
 	d.SetId(id)
 
-	for _, s := range *pvmList {
+	for i, s := range *pvmList {
 		if dt, ok := d.GetOk(PIInstanceDeploymentType); ok && dt.(string) == "VMNoStorage" {
 			_, err = isWaitForPIInstanceShutoff(ctx, client, *s.PvmInstanceID, instanceReadyStatus)
 			if err != nil {
-				return diag.FromErr(err)
+				return piDiagFromErr(partialIBMPIInstanceCreateError(err, *pvmList, i))
 			}
 		} else {
 			_, err = isWaitForPIInstanceAvailable(ctx, client, *s.PvmInstanceID, instanceReadyStatus)
 			if err != nil {
-				return diag.FromErr(err)
+				return piDiagFromErr(partialIBMPIInstanceCreateError(err, *pvmList, i))
+			}
+			if d.Get(Arg_NetworkHealthCheck).(bool) {
+				networkClient := st.NewIBMPINetworkClient(ctx, sess, cloudInstanceID)
+				_, err = isWaitForPIInstanceNetworksActive(ctx, client, networkClient, *s.PvmInstanceID, d.Timeout(schema.TimeoutCreate))
+				if err != nil {
+					return piDiagFromErr(partialIBMPIInstanceCreateError(err, *pvmList, i))
+				}
 			}
 		}
 	}
 
-	// If Storage Pool Affinity is given as false we need to update the vm instance.
-	// Default value is true which indicates that all volumes attached to the server
-	// must reside in the same storage pool.
+	// If Storage Pool Affinity is given as false it is sent in the create body, but SAP
+	// instances cannot set it at create and the backend may not honour it, so update the
+	// instances that still have it enabled. Default value is true which indicates that all
+	// volumes attached to the server must reside in the same storage pool.
 	storagePoolAffinity := d.Get(PIInstanceStoragePoolAffinity).(bool)
 	if !storagePoolAffinity {
 		for _, s := range *pvmList {
+			pvm, err := client.Get(*s.PvmInstanceID)
+			if err != nil {
+				return piDiagFromErr(err)
+			}
+			if pvm.StoragePoolAffinity != nil && !*pvm.StoragePoolAffinity {
+				continue
+			}
 			body := &models.PVMInstanceUpdate{
 				StoragePoolAffinity: &storagePoolAffinity,
 			}
 			// This is a synchronous process hence no need to check for health status
 			_, err = client.Update(*s.PvmInstanceID, body)
 			if err != nil {
-				return diag.FromErr(err)
+				return piDiagFromErr(err)
 			}
 		}
 	}
 	// If virtual optical device provided then update cloud initialization
 	if vod, ok := d.GetOk(PIVirtualOpticalDevice); ok {
+		// Instances deployed without storage stay shut off, so they never boot with the device
+		detach := d.Get(Arg_DetachVirtualOpticalDevice).(bool) && d.Get(PIInstanceDeploymentType).(string) != "VMNoStorage"
 		for _, s := range *pvmList {
-			body := &models.PVMInstanceUpdate{
-				CloudInitialization: &models.CloudInitialization{
-					VirtualOpticalDevice: vod.(string),
-				},
-			}
-			_, err = client.Update(*s.PvmInstanceID, body)
+			err = updateIBMPIInstanceVirtualOpticalDevice(ctx, client, *s.PvmInstanceID, vod.(string), detach, instanceReadyStatus, d.Timeout(schema.TimeoutCreate))
 			if err != nil {
-				return diag.FromErr(err)
+				return piDiagFromErr(err)
 			}
 		}
 	}
@@ -491,21 +589,20 @@ func resourceIBMPIInstanceCreate(ctx context.Context, d *schema.ResourceData, me
 func resourceIBMPIInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
-	idArr, err := flex.IdParts(d.Id())
+	cloudInstanceID, instanceIDs, err := splitInstanceID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
-	cloudInstanceID := idArr[0]
-	instanceID := idArr[1]
+	instanceID := instanceIDs[0]
 
 	client := st.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
 	powervmdata, err := client.Get(instanceID)
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	d.Set(helpers.PIInstanceMemory, powervmdata.Memory)
@@ -520,7 +617,11 @@ func resourceIBMPIInstanceRead(ctx context.Context, d *schema.ResourceData, meta
 		d.Set(helpers.PIInstanceStorageType, powervmdata.StorageType)
 	}
 	d.Set(PIInstanceStoragePool, powervmdata.StoragePool)
-	d.Set(PIInstanceStoragePoolAffinity, powervmdata.StoragePoolAffinity)
+	// Keep the applied storage pool affinity when drift is ignored, so a change made outside of
+	// Terraform is not reverted by the next apply.
+	if _, ok := d.GetOk(Arg_IgnoreStoragePoolAffinityDrift); !ok || d.IsNewResource() {
+		d.Set(PIInstanceStoragePoolAffinity, powervmdata.StoragePoolAffinity)
+	}
 	d.Set(helpers.PICloudInstanceId, cloudInstanceID)
 	d.Set("instance_id", powervmdata.PvmInstanceID)
 	d.Set(helpers.PIInstanceName, powervmdata.ServerName)
@@ -547,7 +648,15 @@ func resourceIBMPIInstanceRead(ctx context.Context, d *schema.ResourceData, meta
 			}
 		}
 	}
-	d.Set(PIInstanceNetwork, networksMap)
+	knownNetworks := d.Get(PIInstanceNetwork).([]interface{})
+	networks, matched := orderPVMNetworks(knownNetworks, networksMap)
+	// Networks attached outside of pi_network, for example by ibm_pi_network_port_attach, are not
+	// managed by the instance and are left out so that an update does not detach them. On import
+	// there are no known networks and all of them are kept.
+	if len(knownNetworks) > 0 {
+		networks = networks[:matched]
+	}
+	d.Set(PIInstanceNetwork, networks)
 
 	if powervmdata.SapProfile != nil && powervmdata.SapProfile.ProfileID != nil {
 		d.Set(PISAPInstanceProfileID, powervmdata.SapProfile.ProfileID)
@@ -563,6 +672,19 @@ func resourceIBMPIInstanceRead(ctx context.Context, d *schema.ResourceData, meta
 	if powervmdata.Health != nil {
 		d.Set("health_status", powervmdata.Health.Status)
 	}
+	d.Set(Attr_HostID, powervmdata.HostID)
+	if pgID := powervmdata.PlacementGroup; pgID != nil && *pgID != "" && *pgID != "none" {
+		pgClient := st.NewIBMPIPlacementGroupClient(ctx, sess, cloudInstanceID)
+		members, satisfied, err := getIBMPIInstancePlacement(client, pgClient, *pgID)
+		if err != nil {
+			log.Printf("[WARN] failed to verify the placement of instance %s in placement group %s: %v", instanceID, *pgID, err)
+		}
+		d.Set(Attr_PlacementGroupMembers, members)
+		d.Set(Attr_PlacementSatisfied, satisfied)
+	} else {
+		d.Set(Attr_PlacementGroupMembers, nil)
+		d.Set(Attr_PlacementSatisfied, false)
+	}
 	if powervmdata.VirtualCores != nil {
 		d.Set(helpers.PIVirtualCoresAssigned, powervmdata.VirtualCores.Assigned)
 		d.Set("max_virtual_cores", powervmdata.VirtualCores.Max)
@@ -605,10 +727,11 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		return diag.Errorf("failed to get the session from the IBM Cloud Service")
 	}
 
-	cloudInstanceID, instanceID, err := splitID(d.Id())
+	cloudInstanceID, instanceIDs, err := splitInstanceID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
+	instanceID := instanceIDs[0]
 
 	client := st.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
 
@@ -616,7 +739,7 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 	cloudInstanceClient := st.NewIBMPICloudInstanceClient(ctx, sess, cloudInstanceID)
 	cloudInstance, err := cloudInstanceClient.Get(cloudInstanceID)
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	cores_enabled := checkCloudInstanceCapability(cloudInstance, CUSTOM_VIRTUAL_CORES)
 
@@ -626,7 +749,9 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 			body.ServerName = name
 		}
 		if d.HasChange(PIVirtualOpticalDevice) {
-			body.CloudInitialization.VirtualOpticalDevice = d.Get(PIVirtualOpticalDevice).(string)
+			body.CloudInitialization = &models.CloudInitialization{
+				VirtualOpticalDevice: d.Get(PIVirtualOpticalDevice).(string),
+			}
 		}
 		_, err = client.Update(instanceID, body)
 		if err != nil {
@@ -634,7 +759,7 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 		_, err = isWaitForPIInstanceAvailable(ctx, client, instanceID, "OK")
 		if err != nil {
-			return diag.FromErr(err)
+			return piDiagFromErr(err)
 		}
 	}
 
@@ -643,9 +768,9 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		if d.Get("status") == "SHUTOFF" {
 			log.Printf("the lpar is in the shutoff state. Nothing to do . Moving on ")
 		} else {
-			err := stopLparForResourceChange(ctx, client, instanceID)
+			err := stopLparForResourceChange(ctx, client, instanceID, d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
-				return diag.FromErr(err)
+				return piDiagFromErr(err)
 			}
 		}
 
@@ -660,17 +785,17 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 		_, err = client.Update(instanceID, updatebody)
 		if err != nil {
-			return diag.FromErr(err)
+			return piDiagFromErr(err)
 		}
-		_, err = isWaitForPIInstanceStopped(ctx, client, instanceID)
+		_, err = isWaitForPIInstanceStopped(ctx, client, instanceID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return piDiagFromErr(err)
 		}
 
 		// Start the lpar
 		err := startLparAfterResourceChange(ctx, client, instanceID)
 		if err != nil {
-			return diag.FromErr(err)
+			return piDiagFromErr(err)
 		}
 	}
 
@@ -685,7 +810,7 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 		_, err = isWaitForPIInstanceAvailable(ctx, client, instanceID, "OK")
 		if err != nil {
-			return diag.FromErr(err)
+			return piDiagFromErr(err)
 		}
 	}
 
@@ -709,9 +834,9 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		log.Printf("the instance state is %s", instanceState)
 
 		if (mem > maxMemLpar || procs > maxCPULpar) && instanceState != "SHUTOFF" {
-			err = performChangeAndReboot(ctx, client, instanceID, cloudInstanceID, mem, procs)
+			err = performChangeAndReboot(ctx, client, instanceID, cloudInstanceID, mem, procs, d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
-				return diag.FromErr(err)
+				return piDiagFromErr(err)
 			}
 
 		} else {
@@ -733,12 +858,12 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 			if instanceState == "SHUTOFF" {
 				_, err = isWaitforPIInstanceUpdate(ctx, client, instanceID)
 				if err != nil {
-					return diag.FromErr(err)
+					return piDiagFromErr(err)
 				}
 			} else {
 				_, err = isWaitForPIInstanceAvailable(ctx, client, instanceID, "OK")
 				if err != nil {
-					return diag.FromErr(err)
+					return piDiagFromErr(err)
 				}
 			}
 		}
@@ -757,18 +882,25 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 		_, err = isWaitForPIInstanceAvailable(ctx, client, instanceID, "OK")
 		if err != nil {
-			diag.FromErr(err)
+			piDiagFromErr(err)
 		}
 	}
 
 	if d.HasChange(PISAPInstanceProfileID) {
+		// Check the new profile first, so an invalid resize does not shut the lpar down
+		sapClient := st.NewIBMPISAPInstanceClient(ctx, sess, cloudInstanceID)
+		err = validateIBMPISAPProfileChange(sapClient, d.Get(PISAPInstanceProfileID).(string), d.Get(helpers.PIInstanceSystemType).(string))
+		if err != nil {
+			return piDiagFromErr(err)
+		}
+
 		// Stop the lpar
 		if d.Get("status") == "SHUTOFF" {
 			log.Printf("the lpar is in the shutoff state. Nothing to do... Moving on ")
 		} else {
-			err := stopLparForResourceChange(ctx, client, instanceID)
+			err := stopLparForResourceChange(ctx, client, instanceID, d.Timeout(schema.TimeoutUpdate))
 			if err != nil {
-				return diag.FromErr(err)
+				return piDiagFromErr(err)
 			}
 		}
 
@@ -783,15 +915,15 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 
 		// Wait for the resize to complete and status to reset
-		_, err = isWaitForPIInstanceStopped(ctx, client, instanceID)
+		_, err = isWaitForPIInstanceStopped(ctx, client, instanceID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return piDiagFromErr(err)
 		}
 
 		// Start the lpar
 		err := startLparAfterResourceChange(ctx, client, instanceID)
 		if err != nil {
-			return diag.FromErr(err)
+			return piDiagFromErr(err)
 		}
 	}
 	if d.HasChange(PIInstanceStoragePoolAffinity) {
@@ -802,7 +934,28 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		// This is a synchronous process hence no need to check for health status
 		_, err = client.Update(instanceID, body)
 		if err != nil {
-			return diag.FromErr(err)
+			return piDiagFromErr(err)
+		}
+	}
+
+	if d.HasChange(PIInstanceNetwork) {
+		oldNetworks, _ := d.GetChange(PIInstanceNetwork)
+		err = updatePVMNetworks(ctx, client, instanceID, oldNetworks.([]interface{}), configuredPVMNetworks(d), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return piDiagFromErr(err)
+		}
+	}
+
+	// The pin policy controls whether the instance can be moved to another host, for example by
+	// live partition migration or automated remote restart.
+	if d.HasChange(helpers.PIInstancePinPolicy) {
+		body := &models.PVMInstanceUpdate{
+			PinPolicy: models.PinPolicy(d.Get(helpers.PIInstancePinPolicy).(string)),
+		}
+		// This is a synchronous process hence no need to check for health status
+		_, err = client.Update(instanceID, body)
+		if err != nil {
+			return piDiagFromErr(err)
 		}
 	}
 
@@ -823,12 +976,12 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 			if err != nil {
 				// ignore delete member error where the server is already not in the PG
 				if !strings.Contains(err.Error(), "is not part of placement-group") {
-					return diag.FromErr(err)
+					return piDiagFromErr(err)
 				}
 			} else {
 				_, err = isWaitForPIInstancePlacementGroupDelete(ctx, pgClient, *pgID.ID, instanceID)
 				if err != nil {
-					return diag.FromErr(err)
+					return piDiagFromErr(err)
 				}
 			}
 		}
@@ -841,11 +994,11 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 			}
 			pgID, err := pgClient.AddMember(placementGroupID, body)
 			if err != nil {
-				return diag.FromErr(err)
+				return piDiagFromErr(err)
 			} else {
 				_, err = isWaitForPIInstancePlacementGroupAdd(ctx, pgClient, *pgID.ID, instanceID)
 				if err != nil {
-					return diag.FromErr(err)
+					return piDiagFromErr(err)
 				}
 			}
 		}
@@ -856,7 +1009,7 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		} else {
 			_, err = isWaitForPIInstanceAvailable(ctx, client, instanceID, "OK")
 			if err != nil {
-				return diag.FromErr(err)
+				return piDiagFromErr(err)
 			}
 		}
 
@@ -873,11 +1026,11 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		updatebody := &models.PVMInstanceUpdate{SoftwareLicenses: sl}
 		_, err = client.Update(instanceID, updatebody)
 		if err != nil {
-			return diag.FromErr(err)
+			return piDiagFromErr(err)
 		}
 		_, err = isWaitForPIInstanceSoftwareLicenses(ctx, client, instanceID, sl)
 		if err != nil {
-			return diag.FromErr(err)
+			return piDiagFromErr(err)
 		}
 	}
 	return resourceIBMPIInstanceRead(ctx, d, meta)
@@ -886,27 +1039,43 @@ func resourceIBMPIInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 func resourceIBMPIInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
-	idArr, err := flex.IdParts(d.Id())
+	cloudInstanceID, instanceIDs, err := splitInstanceID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
-	cloudInstanceID := idArr[0]
 	client := st.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
-	for _, instanceID := range idArr[1:] {
-		err = client.Delete(instanceID)
+	// The shutdown and the delete share the delete timeout
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutDelete))
+	if action := d.Get(Arg_ShutdownOnDelete).(string); action != "" && action != "none" {
+		for _, instanceID := range instanceIDs {
+			err = shutdownIBMPIInstanceForDelete(ctx, client, instanceID, action, time.Until(deadline))
+			if err != nil {
+				return piDiagFromErr(err)
+			}
+		}
+	}
+
+	deleteDataVolumes := d.Get(Arg_DeleteDataVolumes).(bool)
+	deleteRequested := time.Now()
+	for _, instanceID := range instanceIDs {
+		if deleteDataVolumes {
+			err = deleteIBMPIInstanceWithDataVolumes(ctx, sess, cloudInstanceID, instanceID)
+		} else {
+			err = client.Delete(instanceID)
+		}
 		if err != nil {
-			return diag.FromErr(err)
+			return piDiagFromErr(err)
 		}
 	}
 
-	for _, instanceID := range idArr[1:] {
-		_, err = isWaitForPIInstanceDeleted(ctx, client, instanceID)
+	for _, instanceID := range instanceIDs {
+		_, err = isWaitForPIInstanceDeleted(ctx, client, instanceID, deleteRequested, time.Until(deadline))
 		if err != nil {
-			return diag.FromErr(err)
+			return piDiagFromErr(err)
 		}
 	}
 
@@ -914,46 +1083,154 @@ func resourceIBMPIInstanceDelete(ctx context.Context, d *schema.ResourceData, me
 	return nil
 }
 
-func isWaitForPIInstanceDeleted(ctx context.Context, client *st.IBMPIInstanceClient, id string) (interface{}, error) {
+// shutdownIBMPIInstanceForDelete runs the stop or immediate-shutdown action on an instance
+// that is not shut off yet, and waits for it to be shut off.
+func shutdownIBMPIInstanceForDelete(ctx context.Context, client *st.IBMPIInstanceClient, id, action string, timeout time.Duration) error {
+	pvm, err := client.Get(id)
+	if err != nil {
+		return err
+	}
+	if pvm.Status != nil && *pvm.Status == StatusShutoff {
+		return nil
+	}
+
+	err = client.Action(id, &models.PVMInstanceAction{Action: &action})
+	if err != nil {
+		return fmt.Errorf("failed to perform the %s action on the pvm instance %s before deleting it: %w", action, id, err)
+	}
+	_, err = isWaitForPIInstanceStopped(ctx, client, id, timeout)
+	return err
+}
+
+// deleteIBMPIInstanceWithDataVolumes deletes an instance along with its data volumes. The
+// instance client of the SDK cannot request it, so the generated client is used.
+func deleteIBMPIInstanceWithDataVolumes(ctx context.Context, sess *ibmpisession.IBMPISession, cloudInstanceID, id string) error {
+	params := p_cloud_p_vm_instances.NewPcloudPvminstancesDeleteParams().
+		WithContext(ctx).WithTimeout(helpers.PIDeleteTimeOut).
+		WithCloudInstanceID(cloudInstanceID).WithPvmInstanceID(id).
+		WithDeleteDataVolumes(flex.PtrToBool(true))
+	_, err := sess.Power.PCloudpVMInstances.PcloudPvminstancesDelete(params, sess.AuthInfo(cloudInstanceID))
+	if err != nil {
+		return fmt.Errorf("failed to Delete PVM Instance %s :%w", id, err)
+	}
+	return nil
+}
+
+func isWaitForPIInstanceDeleted(ctx context.Context, client *st.IBMPIInstanceClient, id string, deleteRequested time.Time, timeout time.Duration) (interface{}, error) {
 
 	log.Printf("Waiting for  (%s) to be deleted.", id)
 
 	stateConf := &retry.StateChangeConf{
 		Pending:    []string{"retry", helpers.PIInstanceDeleting},
 		Target:     []string{helpers.PIInstanceNotFound},
-		Refresh:    isPIInstanceDeleteRefreshFunc(client, id),
+		Refresh:    isPIInstanceDeleteRefreshFunc(client, id, deleteRequested),
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
-		Timeout:    10 * time.Minute,
+		Timeout:    timeout,
 	}
 
 	return stateConf.WaitForStateContext(ctx)
 }
 
-func isPIInstanceDeleteRefreshFunc(client *st.IBMPIInstanceClient, id string) retry.StateRefreshFunc {
+// isPIInstanceDeleteRefreshFunc reports the instance as deleted only once it is not found. An
+// instance in the ERROR state with a fault raised after the delete was requested failed to be
+// deleted; an instance that was already in ERROR keeps being waited for.
+func isPIInstanceDeleteRefreshFunc(client *st.IBMPIInstanceClient, id string, deleteRequested time.Time) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		pvm, err := client.Get(id)
 		if err != nil {
-			log.Printf("The power vm does not exist")
-			return pvm, helpers.PIInstanceNotFound, nil
+			if _, ok := errors.Unwrap(err).(*p_cloud_p_vm_instances.PcloudPvminstancesGetNotFound); ok {
+				log.Printf("The power vm does not exist")
+				return pvm, helpers.PIInstanceNotFound, nil
+			}
+			if piReasonCode(err) == Reason_Transient {
+				log.Printf("[DEBUG] get of power vm %s being deleted failed, retrying: %v", id, err)
+				return nil, "retry", nil
+			}
+			return nil, "", err
+		}
+		if pvm.Status != nil && strings.EqualFold(*pvm.Status, State_Error) && pvm.Fault != nil && time.Time(pvm.Fault.Created).After(deleteRequested) {
+			return pvm, *pvm.Status, fmt.Errorf("failed to delete the lpar %s, it still exists: %s", id, pvm.Fault.Message)
 		}
 		return pvm, helpers.PIInstanceDeleting, nil
 	}
 }
 
+// validateIBMPISAPProfileChange checks that the SAP profile exists and supports the system type of
+// the instance, before the instance is stopped to resize it to the profile.
+func validateIBMPISAPProfileChange(sapClient *st.IBMPISAPInstanceClient, profileID, sysType string) error {
+	profile, err := sapClient.GetSAPProfile(profileID)
+	if err != nil {
+		return fmt.Errorf("failed to get the sap profile %s: %w", profileID, err)
+	}
+	if sysType != "" && len(profile.SupportedSystems) > 0 && !slices.Contains(profile.SupportedSystems, sysType) {
+		return fmt.Errorf("the sap profile %s does not support the system type %s of the lpar, it supports %s", profileID, sysType, strings.Join(profile.SupportedSystems, ", "))
+	}
+	return nil
+}
+
+// detachIBMPIInstanceVirtualOpticalDevice detaches the cloud initialization virtual optical device
+// of the instance.
+// updateIBMPIInstanceVirtualOpticalDevice attaches or detaches the cloud initialization virtual
+// optical device of a new instance. The instance has already booted when the device is attached,
+// so to detach it, the instance is stopped and started again to boot with the device, and the
+// device is detached once the instance is ready after that boot.
+func updateIBMPIInstanceVirtualOpticalDevice(ctx context.Context, client *st.IBMPIInstanceClient, id, vod string, detach bool, instanceReadyStatus string, timeout time.Duration) error {
+	body := &models.PVMInstanceUpdate{
+		CloudInitialization: &models.CloudInitialization{
+			VirtualOpticalDevice: vod,
+		},
+	}
+	_, err := client.Update(id, body)
+	if err != nil {
+		return err
+	}
+	if vod != models.CloudInitializationVirtualOpticalDeviceAttach || !detach {
+		return nil
+	}
+
+	deadline := time.Now().Add(timeout)
+	err = performInstanceAction(ctx, client, id, "stop", Health_OK, time.Until(deadline))
+	if err != nil {
+		return fmt.Errorf("failed to stop the lpar %s to boot it with the virtual optical device: %w", id, err)
+	}
+	err = performInstanceAction(ctx, client, id, "start", Health_OK, time.Until(deadline))
+	if err != nil {
+		return fmt.Errorf("failed to start the lpar %s with the virtual optical device: %w", id, err)
+	}
+	_, err = isWaitForPIInstanceAvailable(ctx, client, id, instanceReadyStatus)
+	if err != nil {
+		return err
+	}
+	return detachIBMPIInstanceVirtualOpticalDevice(client, id)
+}
+
+func detachIBMPIInstanceVirtualOpticalDevice(client *st.IBMPIInstanceClient, id string) error {
+	body := &models.PVMInstanceUpdate{
+		CloudInitialization: &models.CloudInitialization{
+			VirtualOpticalDevice: models.CloudInitializationVirtualOpticalDeviceDetach,
+		},
+	}
+	_, err := client.Update(id, body)
+	if err != nil {
+		return fmt.Errorf("failed to detach the virtual optical device of the lpar %s: %w", id, err)
+	}
+	return nil
+}
+
 func isWaitForPIInstanceAvailable(ctx context.Context, client *st.IBMPIInstanceClient, id string, instanceReadyStatus string) (interface{}, error) {
 	log.Printf("Waiting for PIInstance (%s) to be available and active ", id)
 
 	queryTimeOut := activeTimeOut
-	if instanceReadyStatus == helpers.PIInstanceHealthWarning {
+	if instanceReadyStatus == Health_Warning {
 		queryTimeOut = warningTimeOut
 	}
 
 	stateConf := &retry.StateChangeConf{
-		Pending:    []string{"PENDING", helpers.PIInstanceBuilding, helpers.PIInstanceHealthWarning},
-		Target:     []string{helpers.PIInstanceAvailable, helpers.PIInstanceHealthOk, "ERROR", "", "SHUTOFF"},
+		Pending:    []string{"PENDING", helpers.PIInstanceBuilding, Health_Warning},
+		Target:     []string{helpers.PIInstanceAvailable, Health_OK, "ERROR", "", "SHUTOFF"},
 		Refresh:    isPIInstanceRefreshFunc(client, id, instanceReadyStatus),
-		Delay:      30 * time.Second,
+		Delay:      piInstanceWaitDelay(30 * time.Second),
 		MinTimeout: queryTimeOut,
 		Timeout:    120 * time.Minute,
 	}
@@ -969,7 +1246,7 @@ func isPIInstanceRefreshFunc(client *st.IBMPIInstanceClient, id, instanceReadySt
 			return nil, "", err
 		}
 		// Check for `instanceReadyStatus` health status and also the final health status "OK"
-		if *pvm.Status == helpers.PIInstanceAvailable && (pvm.Health.Status == instanceReadyStatus || pvm.Health.Status == helpers.PIInstanceHealthOk) {
+		if *pvm.Status == helpers.PIInstanceAvailable && (pvm.Health.Status == instanceReadyStatus || pvm.Health.Status == Health_OK) {
 			return pvm, helpers.PIInstanceAvailable, nil
 		}
 		if *pvm.Status == "ERROR" {
@@ -985,6 +1262,80 @@ func isPIInstanceRefreshFunc(client *st.IBMPIInstanceClient, id, instanceReadySt
 	}
 }
 
+// partialIBMPIInstanceCreateError wraps an error returned while waiting for the replica at
+// index failed of a create with the replicas that were created, so they can be found after
+// a timeout or an interrupted apply. The replicas before it finished their waits.
+func partialIBMPIInstanceCreateError(err error, pvms models.PVMInstanceList, failed int) error {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		err = fmt.Errorf("create interrupted: %w", err)
+	}
+
+	replicas := make([]string, 0, len(pvms))
+	for i, pvm := range pvms {
+		if pvm == nil || pvm.PvmInstanceID == nil {
+			continue
+		}
+		name := ""
+		if pvm.ServerName != nil {
+			name = *pvm.ServerName
+		}
+		status := "ready"
+		if i >= failed {
+			status = "not ready"
+		}
+		replicas = append(replicas, fmt.Sprintf("%s (%s, %s)", name, *pvm.PvmInstanceID, status))
+	}
+
+	return fmt.Errorf("%w; the following instances were created and are kept in state as tainted: %s", err, strings.Join(replicas, ", "))
+}
+
+// getIBMPIInstancePlacement returns the members of the placement group with the host each one is
+// placed on, and whether the hosts honor the policy of the group. The placement is only
+// satisfied when the hosts of all members are known. The hosts are read from a single list of the
+// instances of the workspace, so reading every member of a group does not get each member again.
+func getIBMPIInstancePlacement(client *st.IBMPIInstanceClient, pgClient *st.IBMPIPlacementGroupClient, pgID string) ([]map[string]interface{}, bool, error) {
+	pg, err := pgClient.Get(pgID)
+	if err != nil {
+		return nil, false, err
+	}
+	pvms, err := client.GetAll()
+	if err != nil {
+		return nil, false, err
+	}
+	hostIDs := make(map[string]int64, len(pvms.PvmInstances))
+	for _, pvm := range pvms.PvmInstances {
+		if pvm != nil && pvm.PvmInstanceID != nil {
+			hostIDs[*pvm.PvmInstanceID] = pvm.HostID
+		}
+	}
+
+	members := make([]map[string]interface{}, 0, len(pg.Members))
+	hosts := map[int64]bool{}
+	known := true
+	for _, id := range pg.Members {
+		hostID := hostIDs[id]
+		members = append(members, map[string]interface{}{
+			Attr_HostID:        hostID,
+			Attr_PVMInstanceID: id,
+		})
+		if hostID == 0 {
+			known = false
+		}
+		hosts[hostID] = true
+	}
+	if !known || pg.Policy == nil {
+		return members, false, nil
+	}
+
+	switch *pg.Policy {
+	case models.PlacementGroupPolicyAffinity:
+		return members, len(hosts) <= 1, nil
+	case models.PlacementGroupPolicyAntiDashAffinity:
+		return members, len(hosts) == len(pg.Members), nil
+	}
+	return members, false, nil
+}
+
 func isWaitForPIInstancePlacementGroupAdd(ctx context.Context, client *st.IBMPIPlacementGroupClient, pgID string, id string) (interface{}, error) {
 	log.Printf("Waiting for PIInstance Placement Group (%s) to be updated ", id)
 
@@ -1106,13 +1457,13 @@ func isWaitForPIInstanceShutoff(ctx context.Context, client *st.IBMPIInstanceCli
 	log.Printf("Waiting for PIInstance (%s) to be shutoff and health active ", id)
 
 	queryTimeOut := activeTimeOut
-	if instanceReadyStatus == helpers.PIInstanceHealthWarning {
+	if instanceReadyStatus == Health_Warning {
 		queryTimeOut = warningTimeOut
 	}
 
 	stateConf := &retry.StateChangeConf{
-		Pending:    []string{StatusPending, helpers.PIInstanceBuilding, helpers.PIInstanceHealthWarning},
-		Target:     []string{helpers.PIInstanceHealthOk, StatusError, "", StatusShutoff},
+		Pending:    []string{StatusPending, helpers.PIInstanceBuilding, Health_Warning},
+		Target:     []string{Health_OK, StatusError, "", StatusShutoff},
 		Refresh:    isPIInstanceShutoffRefreshFunc(client, id, instanceReadyStatus),
 		Delay:      30 * time.Second,
 		MinTimeout: queryTimeOut,
@@ -1129,7 +1480,7 @@ func isPIInstanceShutoffRefreshFunc(client *st.IBMPIInstanceClient, id, instance
 		if err != nil {
 			return nil, "", err
 		}
-		if *pvm.Status == StatusShutoff && (pvm.Health.Status == instanceReadyStatus || pvm.Health.Status == helpers.PIInstanceHealthOk) {
+		if *pvm.Status == StatusShutoff && (pvm.Health.Status == instanceReadyStatus || pvm.Health.Status == Health_OK) {
 			return pvm, StatusShutoff, nil
 		}
 		if *pvm.Status == StatusError {
@@ -1145,6 +1496,96 @@ func isPIInstanceShutoffRefreshFunc(client *st.IBMPIInstanceClient, id, instance
 	}
 }
 
+// ibmPIInstanceUserData returns the base64 encoded user data of the instance. The API takes a
+// single key pair, so the public keys of the pi_ssh_keys after the first are looked up and added
+// to the user data.
+func ibmPIInstanceUserData(d *schema.ResourceData, keyClient *st.IBMPIKeyClient) (string, error) {
+	userData := d.Get(helpers.PIInstanceUserData).(string)
+	keyNames := flex.ExpandStringList(d.Get(Arg_SSHKeys).([]interface{}))
+	if len(keyNames) < 2 {
+		return encodeBase64(userData), nil
+	}
+	publicKeys := make([]string, 0, len(keyNames)-1)
+	for _, name := range keyNames[1:] {
+		key, err := keyClient.Get(name)
+		if err != nil {
+			return "", err
+		}
+		publicKeys = append(publicKeys, *key.SSHKey)
+	}
+	return addIBMPIInstanceSSHKeysToUserData(userData, publicKeys)
+}
+
+// cloudInitContentTypes maps the first line of a cloud-init user data to its MIME content type.
+var cloudInitContentTypes = []struct {
+	prefix      string
+	contentType string
+}{
+	{"#cloud-config", "text/cloud-config"},
+	{"#cloud-boothook", "text/cloud-boothook"},
+	{"#include", "text/x-include-url"},
+	{"#!", "text/x-shellscript"},
+}
+
+// addIBMPIInstanceSSHKeysToUserData returns the base64 encoded user data with a cloud-config that
+// adds the public keys to the authorized keys of the default user. User data is combined with the
+// cloud-config in a MIME multi-part archive, with a merge type that appends the keys to the ones
+// the user data may already set.
+func addIBMPIInstanceSSHKeysToUserData(userData string, publicKeys []string) (string, error) {
+	if decoded, err := base64.StdEncoding.DecodeString(userData); err == nil {
+		userData = string(decoded)
+	}
+
+	var config strings.Builder
+	config.WriteString("#cloud-config\nssh_authorized_keys:\n")
+	for _, key := range publicKeys {
+		// a JSON string is a valid YAML flow scalar
+		quoted, err := json.Marshal(strings.TrimSpace(key))
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(&config, "  - %s\n", quoted)
+	}
+	if strings.TrimSpace(userData) == "" {
+		return base64.StdEncoding.EncodeToString([]byte(config.String())), nil
+	}
+
+	var contentType string
+	for _, t := range cloudInitContentTypes {
+		if strings.HasPrefix(userData, t.prefix) {
+			contentType = t.contentType
+			break
+		}
+	}
+	if contentType == "" {
+		return "", fmt.Errorf("the public keys of %s cannot be added to the user data, it must start with #cloud-config, #cloud-boothook, #include or #!", Arg_SSHKeys)
+	}
+
+	var archive bytes.Buffer
+	w := multipart.NewWriter(&archive)
+	fmt.Fprintf(&archive, "Content-Type: multipart/mixed; boundary=%q\r\nMIME-Version: 1.0\r\n\r\n", w.Boundary())
+	parts := []struct {
+		header  textproto.MIMEHeader
+		content string
+	}{
+		{textproto.MIMEHeader{"Content-Type": {contentType}}, userData},
+		{textproto.MIMEHeader{"Content-Type": {"text/cloud-config"}, "Merge-Type": {"list(append)+dict(recurse_array)+str()"}}, config.String()},
+	}
+	for _, p := range parts {
+		pw, err := w.CreatePart(p.header)
+		if err != nil {
+			return "", err
+		}
+		if _, err := pw.Write([]byte(p.content)); err != nil {
+			return "", err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(archive.Bytes()), nil
+}
+
 // This function takes the input string and encodes into base64 if isn't already encoded
 func encodeBase64(userData string) string {
 	_, err := base64.StdEncoding.DecodeString(userData)
@@ -1154,16 +1595,16 @@ func encodeBase64(userData string) string {
 	return userData
 }
 
-func isWaitForPIInstanceStopped(ctx context.Context, client *st.IBMPIInstanceClient, id string) (interface{}, error) {
+func isWaitForPIInstanceStopped(ctx context.Context, client *st.IBMPIInstanceClient, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for PIInstance (%s) to be stopped and powered off ", id)
 
 	stateConf := &retry.StateChangeConf{
-		Pending:    []string{"STOPPING", "RESIZE", "VERIFY_RESIZE", helpers.PIInstanceHealthWarning},
+		Pending:    []string{"STOPPING", "RESIZE", "VERIFY_RESIZE", Health_Warning},
 		Target:     []string{"OK", "SHUTOFF"},
 		Refresh:    isPIInstanceRefreshFuncOff(client, id),
-		Delay:      10 * time.Second,
+		Delay:      piInstanceWaitDelay(10 * time.Second),
 		MinTimeout: 2 * time.Minute, // This is the time that the client will execute to check the status of the request
-		Timeout:    30 * time.Minute,
+		Timeout:    timeout,
 	}
 
 	return stateConf.WaitForStateContext(ctx)
@@ -1177,14 +1618,14 @@ func isPIInstanceRefreshFuncOff(client *st.IBMPIInstanceClient, id string) retry
 		if err != nil {
 			return nil, "", err
 		}
-		if *pvm.Status == "SHUTOFF" && pvm.Health.Status == helpers.PIInstanceHealthOk {
+		if *pvm.Status == "SHUTOFF" && pvm.Health.Status == Health_OK {
 			return pvm, "SHUTOFF", nil
 		}
 		return pvm, "STOPPING", nil
 	}
 }
 
-func stopLparForResourceChange(ctx context.Context, client *st.IBMPIInstanceClient, id string) error {
+func stopLparForResourceChange(ctx context.Context, client *st.IBMPIInstanceClient, id string, timeout time.Duration) error {
 	body := &models.PVMInstanceAction{
 		//Action: flex.PtrToString("stop"),
 		Action: flex.PtrToString("immediate-shutdown"),
@@ -1194,7 +1635,7 @@ func stopLparForResourceChange(ctx context.Context, client *st.IBMPIInstanceClie
 		return fmt.Errorf("failed to perform the stop action on the pvm instance %v", err)
 	}
 
-	_, err = isWaitForPIInstanceStopped(ctx, client, id)
+	_, err = isWaitForPIInstanceStopped(ctx, client, id, timeout)
 
 	return err
 }
@@ -1215,7 +1656,7 @@ func startLparAfterResourceChange(ctx context.Context, client *st.IBMPIInstanceC
 }
 
 // Stop / Modify / Start only when the lpar is off limits
-func performChangeAndReboot(ctx context.Context, client *st.IBMPIInstanceClient, id, cloudInstanceID string, mem, procs float64) error {
+func performChangeAndReboot(ctx context.Context, client *st.IBMPIInstanceClient, id, cloudInstanceID string, mem, procs float64, timeout time.Duration) error {
 	/*
 		These are the steps
 		1. Stop the lpar - Check if the lpar is SHUTOFF
@@ -1226,7 +1667,7 @@ func performChangeAndReboot(ctx context.Context, client *st.IBMPIInstanceClient,
 	//Execute the stop
 
 	log.Printf("Calling the stop lpar for Resource Change code ..")
-	err := stopLparForResourceChange(ctx, client, id)
+	err := stopLparForResourceChange(ctx, client, id, timeout)
 	if err != nil {
 		return err
 	}
@@ -1262,9 +1703,9 @@ func isWaitforPIInstanceUpdate(ctx context.Context, client *st.IBMPIInstanceClie
 
 	stateConf := &retry.StateChangeConf{
 		Pending:    []string{"RESIZE", "VERIFY_RESIZE"},
-		Target:     []string{"ACTIVE", "SHUTOFF", helpers.PIInstanceHealthOk},
+		Target:     []string{"ACTIVE", "SHUTOFF", Health_OK},
 		Refresh:    isPIInstanceShutAfterResourceChange(client, id),
-		Delay:      10 * time.Second,
+		Delay:      piInstanceWaitDelay(10 * time.Second),
 		MinTimeout: 5 * time.Minute,
 		Timeout:    60 * time.Minute,
 	}
@@ -1280,7 +1721,7 @@ func isPIInstanceShutAfterResourceChange(client *st.IBMPIInstanceClient, id stri
 			return nil, "", err
 		}
 
-		if *pvm.Status == "SHUTOFF" && pvm.Health.Status == helpers.PIInstanceHealthOk {
+		if *pvm.Status == "SHUTOFF" && pvm.Health.Status == Health_OK {
 			log.Printf("The lpar is now off after the resource change...")
 			return pvm, "SHUTOFF", nil
 		}
@@ -1302,6 +1743,215 @@ func expandPVMNetworks(networks []interface{}) []*models.PVMInstanceAddNetwork {
 	return pvmNetworks
 }
 
+// configuredPVMNetworks returns the networks of pi_network. The IP addresses come from the
+// configuration rather than the plan: an omitted ip_address is planned with the value of the
+// network previously at the same position, which would be wrong once networks before it are
+// removed.
+func configuredPVMNetworks(d *schema.ResourceData) []*models.PVMInstanceAddNetwork {
+	var desired []*models.PVMInstanceAddNetwork
+	if config := d.GetRawConfig(); !config.IsNull() {
+		if networks := config.GetAttr(PIInstanceNetwork); !networks.IsNull() && networks.IsKnown() {
+			for it := networks.ElementIterator(); it.Next(); {
+				_, network := it.Element()
+				n := &models.PVMInstanceAddNetwork{NetworkID: flex.PtrToString(network.GetAttr("network_id").AsString())}
+				if ip := network.GetAttr("ip_address"); !ip.IsNull() && ip.IsKnown() {
+					n.IPAddress = ip.AsString()
+				}
+				desired = append(desired, n)
+			}
+		}
+	}
+	return desired
+}
+
+// updatePVMNetworks attaches the desired networks that are not in the current networks of the
+// state, and detaches the current networks that are not desired.
+func updatePVMNetworks(ctx context.Context, client *st.IBMPIInstanceClient, instanceID string, current []interface{}, desired []*models.PVMInstanceAddNetwork, timeout time.Duration) error {
+	used := make([]bool, len(current))
+	match := func(n *models.PVMInstanceAddNetwork, anyIP bool) bool {
+		for i, v := range current {
+			c := v.(map[string]interface{})
+			if used[i] || c["network_id"].(string) != *n.NetworkID {
+				continue
+			}
+			if !anyIP && c["ip_address"].(string) != n.IPAddress {
+				continue
+			}
+			used[i] = true
+			return true
+		}
+		return false
+	}
+	// Match networks with an IP address first, so they do not take the place of another one
+	var toAdd []*models.PVMInstanceAddNetwork
+	for _, n := range desired {
+		if n.IPAddress != "" && !match(n, false) {
+			toAdd = append(toAdd, n)
+		}
+	}
+	for _, n := range desired {
+		if n.IPAddress == "" && !match(n, true) {
+			toAdd = append(toAdd, n)
+		}
+	}
+
+	var removedMACs []string
+	for i, v := range current {
+		if used[i] {
+			continue
+		}
+		c := v.(map[string]interface{})
+		networkID, macAddress := c["network_id"].(string), c["mac_address"].(string)
+		log.Printf("[DEBUG] detaching network %s (%s) from instance %s", networkID, macAddress, instanceID)
+		err := client.DeleteNetwork(instanceID, networkID, &models.PVMInstanceRemoveNetwork{MacAddress: macAddress})
+		if err != nil {
+			return fmt.Errorf("failed to detach network %s from instance %s: %w", networkID, instanceID, err)
+		}
+		removedMACs = append(removedMACs, macAddress)
+	}
+
+	var addedMACs []string
+	for _, n := range toAdd {
+		log.Printf("[DEBUG] attaching network %s to instance %s", *n.NetworkID, instanceID)
+		network, err := client.AddNetwork(instanceID, n)
+		if err != nil {
+			return fmt.Errorf("failed to attach network %s to instance %s: %w", *n.NetworkID, instanceID, err)
+		}
+		if network != nil && network.MacAddress != "" {
+			addedMACs = append(addedMACs, network.MacAddress)
+		}
+	}
+
+	_, err := isWaitForPIInstanceNetworksUpdated(ctx, client, instanceID, addedMACs, removedMACs, timeout)
+	return err
+}
+
+func isWaitForPIInstanceNetworksUpdated(ctx context.Context, client *st.IBMPIInstanceClient, id string, addedMACs, removedMACs []string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for the networks of PIInstance (%s) to be updated", id)
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{State_Pending},
+		Target:  []string{State_Available},
+		Refresh: func() (interface{}, string, error) {
+			pvm, err := client.Get(id)
+			if err != nil {
+				return nil, "", err
+			}
+			macs := make(map[string]bool, len(pvm.Networks))
+			for _, n := range pvm.Networks {
+				if n != nil {
+					macs[n.MacAddress] = true
+				}
+			}
+			for _, mac := range addedMACs {
+				if !macs[mac] {
+					return pvm, State_Pending, nil
+				}
+			}
+			for _, mac := range removedMACs {
+				if macs[mac] {
+					return pvm, State_Pending, nil
+				}
+			}
+			return pvm, State_Available, nil
+		},
+		Delay:      piInstanceWaitDelay(10 * time.Second),
+		MinTimeout: 10 * time.Second,
+		Timeout:    timeout,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+// isWaitForPIInstanceNetworksActive waits until every network interface of the instance has an IP
+// address and its port on the network is ACTIVE.
+func isWaitForPIInstanceNetworksActive(ctx context.Context, client *st.IBMPIInstanceClient, networkClient *st.IBMPINetworkClient, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for the networks of PIInstance (%s) to be active", id)
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{State_Pending},
+		Target:  []string{State_Available},
+		Refresh: func() (interface{}, string, error) {
+			pvm, err := client.Get(id)
+			if err != nil {
+				return nil, "", err
+			}
+			for _, n := range pvm.Networks {
+				if n == nil {
+					continue
+				}
+				if n.IPAddress == "" {
+					log.Printf("[DEBUG] network %s of PIInstance %s has no IP address yet", n.NetworkID, id)
+					return pvm, State_Pending, nil
+				}
+				ports, err := networkClient.GetAllPorts(n.NetworkID)
+				if err != nil {
+					return nil, "", err
+				}
+				active := false
+				for _, port := range ports.Ports {
+					if port != nil && port.MacAddress != nil && port.Status != nil && strings.EqualFold(*port.MacAddress, n.MacAddress) {
+						active = *port.Status == State_ACTIVE
+						break
+					}
+				}
+				if !active {
+					log.Printf("[DEBUG] port %s of network %s of PIInstance %s is not active yet", n.MacAddress, n.NetworkID, id)
+					return pvm, State_Pending, nil
+				}
+			}
+			return pvm, State_Available, nil
+		},
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+		Timeout:    timeout,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+// orderPVMNetworks orders the networks returned by the API like the networks already known in
+// the configuration or state, matched on network_id and then ip_address. The API does not return
+// networks in a stable order, which otherwise shows up as a diff on every plan. Networks that are
+// not known yet are kept in API order after the known ones; matched is the number of known ones.
+func orderPVMNetworks(known []interface{}, networks []map[string]interface{}) (ordered []map[string]interface{}, matched int) {
+	ordered = make([]map[string]interface{}, 0, len(networks))
+	used := make([]bool, len(networks))
+	match := func(networkID, ipAddress string) {
+		for i, n := range networks {
+			if used[i] || n["network_id"].(string) != networkID {
+				continue
+			}
+			if ipAddress != "" && n["ip_address"].(string) != ipAddress {
+				continue
+			}
+			used[i] = true
+			ordered = append(ordered, n)
+			return
+		}
+	}
+	for _, v := range known {
+		network, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		networkID, _ := network["network_id"].(string)
+		ipAddress, _ := network["ip_address"].(string)
+		before := len(ordered)
+		match(networkID, ipAddress)
+		if len(ordered) == before && ipAddress != "" {
+			match(networkID, "")
+		}
+	}
+	matched = len(ordered)
+	for i, n := range networks {
+		if !used[i] {
+			ordered = append(ordered, n)
+		}
+	}
+	return ordered, matched
+}
+
 func checkCloudInstanceCapability(cloudInstance *models.CloudInstance, custom_capability string) bool {
 	log.Printf("Checking for the following capability %s", custom_capability)
 	log.Printf("the instance features are %s", cloudInstance.Capabilities)
@@ -1313,7 +1963,7 @@ func checkCloudInstanceCapability(cloudInstance *models.CloudInstance, custom_ca
 	return false
 }
 
-func createSAPInstance(d *schema.ResourceData, sapClient *st.IBMPISAPInstanceClient) (*models.PVMInstanceList, error) {
+func createSAPInstance(d *schema.ResourceData, sapClient *st.IBMPISAPInstanceClient, userData string) (*models.PVMInstanceList, error) {
 
 	name := d.Get(helpers.PIInstanceName).(string)
 	profileID := d.Get(PISAPInstanceProfileID).(string)
@@ -1367,9 +2017,11 @@ func createSAPInstance(d *schema.ResourceData, sapClient *st.IBMPISAPInstanceCli
 		sshkey := v.(string)
 		body.SSHKeyName = sshkey
 	}
-	if u, ok := d.GetOk(helpers.PIInstanceUserData); ok {
-		userData := u.(string)
-		body.UserData = encodeBase64(userData)
+	if keys := d.Get(Arg_SSHKeys).([]interface{}); len(keys) > 0 {
+		body.SSHKeyName = keys[0].(string)
+	}
+	if userData != "" {
+		body.UserData = userData
 	}
 	if sys, ok := d.GetOk(helpers.PIInstanceSystemType); ok {
 		body.SysType = sys.(string)
@@ -1382,27 +2034,27 @@ func createSAPInstance(d *schema.ResourceData, sapClient *st.IBMPISAPInstanceCli
 		body.StoragePool = sp.(string)
 	}
 
-	if ap, ok := d.GetOk(PIAffinityPolicy); ok {
+	if ap, ok := d.GetOk(Arg_AffinityPolicy); ok {
 		policy := ap.(string)
 		affinity := &models.StorageAffinity{
 			AffinityPolicy: &policy,
 		}
 
 		if policy == "affinity" {
-			if av, ok := d.GetOk(PIAffinityVolume); ok {
+			if av, ok := d.GetOk(Arg_AffinityVolume); ok {
 				afvol := av.(string)
 				affinity.AffinityVolume = &afvol
 			}
-			if ai, ok := d.GetOk(PIAffinityInstance); ok {
+			if ai, ok := d.GetOk(Arg_AffinityInstance); ok {
 				afins := ai.(string)
 				affinity.AffinityPVMInstance = &afins
 			}
 		} else {
-			if avs, ok := d.GetOk(PIAntiAffinityVolumes); ok {
+			if avs, ok := d.GetOk(Arg_AntiAffinityVolumes); ok {
 				afvols := flex.ExpandStringList(avs.([]interface{}))
 				affinity.AntiAffinityVolumes = afvols
 			}
-			if ais, ok := d.GetOk(PIAntiAffinityInstances); ok {
+			if ais, ok := d.GetOk(Arg_AntiAffinityInstances); ok {
 				afinss := flex.ExpandStringList(ais.([]interface{}))
 				affinity.AntiAffinityPVMInstances = afinss
 			}
@@ -1427,7 +2079,7 @@ func createSAPInstance(d *schema.ResourceData, sapClient *st.IBMPISAPInstanceCli
 	return pvmList, nil
 }
 
-func createPVMInstance(d *schema.ResourceData, client *st.IBMPIInstanceClient, imageClient *st.IBMPIImageClient) (*models.PVMInstanceList, error) {
+func createPVMInstance(d *schema.ResourceData, client *st.IBMPIInstanceClient, imageClient *st.IBMPIImageClient, userData string) (*models.PVMInstanceList, error) {
 
 	name := d.Get(helpers.PIInstanceName).(string)
 	imageid := d.Get(helpers.PIInstanceImageId).(string)
@@ -1481,11 +2133,6 @@ func createPVMInstance(d *schema.ResourceData, client *st.IBMPIInstanceClient, i
 		}
 	}
 
-	var userData string
-	if u, ok := d.GetOk(helpers.PIInstanceUserData); ok {
-		userData = u.(string)
-	}
-
 	body := &models.PVMInstanceCreate{
 		Processors:              &procs,
 		Memory:                  &mem,
@@ -1494,7 +2141,7 @@ func createPVMInstance(d *schema.ResourceData, client *st.IBMPIInstanceClient, i
 		ImageID:                 flex.PtrToString(imageid),
 		ProcType:                flex.PtrToString(processortype),
 		Replicants:              replicants,
-		UserData:                encodeBase64(userData),
+		UserData:                userData,
 		ReplicantNamingScheme:   flex.PtrToString(replicationNamingScheme),
 		ReplicantAffinityPolicy: flex.PtrToString(replicationpolicy),
 		Networks:                pvmNetworks,
@@ -1503,12 +2150,18 @@ func createPVMInstance(d *schema.ResourceData, client *st.IBMPIInstanceClient, i
 		sshkey := s.(string)
 		body.KeyPairName = sshkey
 	}
+	if keys := d.Get(Arg_SSHKeys).([]interface{}); len(keys) > 0 {
+		body.KeyPairName = keys[0].(string)
+	}
 	if len(volids) > 0 {
 		body.VolumeIDs = volids
 	}
 	if d.Get(helpers.PIInstancePinPolicy) == "soft" || d.Get(helpers.PIInstancePinPolicy) == "hard" {
 		body.PinPolicy = models.PinPolicy(pinpolicy)
 	}
+	if storagePoolAffinity := d.Get(PIInstanceStoragePoolAffinity).(bool); !storagePoolAffinity {
+		body.StoragePoolAffinity = &storagePoolAffinity
+	}
 
 	var assignedVirtualCores int64
 	if a, ok := d.GetOk(helpers.PIVirtualCoresAssigned); ok {
@@ -1527,27 +2180,27 @@ func createPVMInstance(d *schema.ResourceData, client *st.IBMPIInstanceClient, i
 		body.DeploymentType = dt.(string)
 	}
 
-	if ap, ok := d.GetOk(PIAffinityPolicy); ok {
+	if ap, ok := d.GetOk(Arg_AffinityPolicy); ok {
 		policy := ap.(string)
 		affinity := &models.StorageAffinity{
 			AffinityPolicy: &policy,
 		}
 
 		if policy == "affinity" {
-			if av, ok := d.GetOk(PIAffinityVolume); ok {
+			if av, ok := d.GetOk(Arg_AffinityVolume); ok {
 				afvol := av.(string)
 				affinity.AffinityVolume = &afvol
 			}
-			if ai, ok := d.GetOk(PIAffinityInstance); ok {
+			if ai, ok := d.GetOk(Arg_AffinityInstance); ok {
 				afins := ai.(string)
 				affinity.AffinityPVMInstance = &afins
 			}
 		} else {
-			if avs, ok := d.GetOk(PIAntiAffinityVolumes); ok {
+			if avs, ok := d.GetOk(Arg_AntiAffinityVolumes); ok {
 				afvols := flex.ExpandStringList(avs.([]interface{}))
 				affinity.AntiAffinityVolumes = afvols
 			}
-			if ais, ok := d.GetOk(PIAntiAffinityInstances); ok {
+			if ais, ok := d.GetOk(Arg_AntiAffinityInstances); ok {
 				afinss := flex.ExpandStringList(ais.([]interface{}))
 				affinity.AntiAffinityPVMInstances = afinss
 			}
@@ -1630,12 +2283,3 @@ func expandDeploymentTarget(dt []interface{}) *models.DeploymentTarget {
 	}
 	return dtexpanded
 }
-func splitID(id string) (id1, id2 string, err error) {
-	parts, err := flex.IdParts(id)
-	if err != nil {
-		return
-	}
-	id1 = parts[0]
-	id2 = parts[1]
-	return
-}
@@ -55,8 +55,8 @@ func ResourceIBMPIInstanceAction() *schema.Resource {
 			Arg_PVMInstanceHealthStatus: {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validate.ValidateAllowedStringValues([]string{PVMInstanceHealthOk, PVMInstanceHealthWarning}),
-				Default:      PVMInstanceHealthOk,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{Health_OK, Health_Warning}),
+				Default:      Health_OK,
 				Description:  "Set the health status of the PVM instance to connect it faster",
 			},
 
@@ -97,18 +97,18 @@ func resourceIBMPIInstanceActionCreate(ctx context.Context, d *schema.ResourceDa
 func resourceIBMPIInstanceActionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID, id, err := splitID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	client := st.NewIBMPIInstanceClient(context.Background(), sess, cloudInstanceID)
 	powervmdata, err := client.Get(id)
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	d.Set(Attr_Status, powervmdata.Status)
@@ -141,7 +141,7 @@ func resourceIBMPIInstanceActionDelete(ctx context.Context, d *schema.ResourceDa
 func takeInstanceAction(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
@@ -149,6 +149,17 @@ func takeInstanceAction(ctx context.Context, d *schema.ResourceData, meta interf
 	action := d.Get(Arg_PVMInstanceActionType).(string)
 	targetHealthStatus := d.Get(Arg_PVMInstanceHealthStatus).(string)
 
+	client := st.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
+	if err := performInstanceAction(ctx, client, id, action, targetHealthStatus, timeout); err != nil {
+		return piDiagFromErr(err)
+	}
+
+	return nil
+}
+
+// performInstanceAction runs an action on an instance and waits for the instance to reach the
+// status and health status the action leads to.
+func performInstanceAction(ctx context.Context, client *st.IBMPIInstanceClient, id, action, targetHealthStatus string, timeout time.Duration) error {
 	var targetStatus string
 	if action == "stop" || action == "immediate-shutdown" {
 		targetStatus = "SHUTOFF"
@@ -160,17 +171,15 @@ func takeInstanceAction(ctx context.Context, d *schema.ResourceData, meta interf
 		targetStatus = "ACTIVE"
 	}
 
-	client := st.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
-
 	// special case for action "start", "stop", "immediate-shutdown"
 	// skip calling action if instance is already in desired state
 	if action == "start" || action == "stop" || action == "immediate-shutdown" {
 		pvm, err := client.Get(id)
 		if err != nil {
-			return diag.FromErr(err)
+			return err
 		}
 
-		if *pvm.Status == targetStatus && pvm.Health != nil && (pvm.Health.Status == targetHealthStatus || pvm.Health.Status == PVMInstanceHealthOk) {
+		if *pvm.Status == targetStatus && pvm.Health != nil && (pvm.Health.Status == targetHealthStatus || pvm.Health.Status == Health_OK) {
 			log.Printf("[DEBUG] skipping as action %s not needed on the instance %s", action, id)
 			return nil
 		}
@@ -179,21 +188,17 @@ func takeInstanceAction(ctx context.Context, d *schema.ResourceData, meta interf
 	body := &models.PVMInstanceAction{Action: &action}
 	log.Printf("Calling the IBM PI Action %s on the instance %s", action, id)
 
-	err = client.Action(id, body)
+	err := client.Action(id, body)
 	if err != nil {
 		log.Printf("[ERROR] failed to perform the action on the instance %v", err)
-		return diag.FromErr(err)
+		return err
 	}
 
 	log.Printf("Executed the action on the instance")
 
 	log.Printf("Calling the check for %s opertion to check for status %s", action, targetStatus)
 	_, err = isWaitForPIInstanceActionStatus(ctx, client, id, timeout, targetStatus, targetHealthStatus)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	return nil
+	return err
 }
 
 func isWaitForPIInstanceActionStatus(ctx context.Context, client *st.IBMPIInstanceClient, id string, timeout time.Duration, targetStatus, targetHealthStatus string) (interface{}, error) {
@@ -203,7 +208,7 @@ func isWaitForPIInstanceActionStatus(ctx context.Context, client *st.IBMPIInstan
 		Pending:    []string{StatusPending},
 		Target:     []string{targetStatus, StatusError, ""},
 		Refresh:    isPIActionRefreshFunc(client, id, targetStatus, targetHealthStatus),
-		Delay:      30 * time.Second,
+		Delay:      piInstanceWaitDelay(30 * time.Second),
 		MinTimeout: 2 * time.Minute,
 		Timeout:    timeout,
 	}
@@ -219,7 +224,7 @@ func isPIActionRefreshFunc(client *st.IBMPIInstanceClient, id, targetStatus, tar
 			return nil, "", err
 		}
 
-		if *pvm.Status == targetStatus && (pvm.Health.Status == targetHealthStatus || pvm.Health.Status == PVMInstanceHealthOk) {
+		if *pvm.Status == targetStatus && (pvm.Health.Status == targetHealthStatus || pvm.Health.Status == Health_OK) {
 			log.Printf("The health status is now %s", pvm.Health.Status)
 			return pvm, targetStatus, nil
 		}
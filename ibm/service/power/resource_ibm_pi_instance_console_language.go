@@ -56,7 +56,7 @@ func ResourceIBMPIInstanceConsoleLanguage() *schema.Resource {
 func resourceIBMPIInstanceConsoleLanguageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
@@ -72,7 +72,7 @@ func resourceIBMPIInstanceConsoleLanguageCreate(ctx context.Context, d *schema.R
 	_, err = client.UpdateConsoleLanguage(instanceName, consoleLanguage)
 	if err != nil {
 		log.Printf("[DEBUG] err %s", err)
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, instanceName))
@@ -88,7 +88,7 @@ func resourceIBMPIInstanceConsoleLanguageRead(ctx context.Context, d *schema.Res
 func resourceIBMPIInstanceConsoleLanguageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	if d.HasChange(Arg_LanguageCode) {
@@ -104,7 +104,7 @@ func resourceIBMPIInstanceConsoleLanguageUpdate(ctx context.Context, d *schema.R
 		_, err = client.UpdateConsoleLanguage(instanceName, consoleLanguage)
 		if err != nil {
 			log.Printf("[DEBUG] err %s", err)
-			return diag.FromErr(err)
+			return piDiagFromErr(err)
 		}
 	}
 	return resourceIBMPIInstanceConsoleLanguageRead(ctx, d, meta)
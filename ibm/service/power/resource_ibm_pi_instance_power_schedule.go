@@ -0,0 +1,163 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMPIInstancePowerSchedule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPIInstancePowerScheduleCreate,
+		ReadContext:   resourceIBMPIInstancePowerScheduleRead,
+		UpdateContext: resourceIBMPIInstancePowerScheduleUpdate,
+		DeleteContext: resourceIBMPIInstancePowerScheduleDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		CustomizeDiff: resourceIBMPIInstancePowerScheduleCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Update: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_CloudInstanceID: {
+				Description:  "The GUID of the service instance associated with an account.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_PowerSchedule: powerScheduleSchema(true),
+			Arg_PVMInstanceId: {
+				Description:  "The ID of the PVM instance.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// Attributes
+			Attr_ScheduledAction: {
+				Computed:    true,
+				Description: "The action the power schedule called for when the last applied plan was made, start or stop.",
+				Type:        schema.TypeString,
+			},
+			Attr_Status: {
+				Computed:    true,
+				Description: "The status of the PVM instance.",
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func resourceIBMPIInstancePowerScheduleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	instanceID := d.Get(Arg_PVMInstanceId).(string)
+
+	if diags := applyInstancePowerSchedule(ctx, d, meta, d.Timeout(schema.TimeoutCreate)); diags != nil {
+		return diags
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, instanceID))
+
+	return resourceIBMPIInstancePowerScheduleRead(ctx, d, meta)
+}
+
+func resourceIBMPIInstancePowerScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+
+	cloudInstanceID, instanceID, err := splitID(d.Id())
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+
+	client := st.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
+	pvm, err := client.Get(instanceID)
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+
+	d.Set(Arg_CloudInstanceID, cloudInstanceID)
+	d.Set(Arg_PVMInstanceId, instanceID)
+	d.Set(Attr_Status, pvm.Status)
+
+	return nil
+}
+
+func resourceIBMPIInstancePowerScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChanges(Arg_PowerSchedule, Attr_ScheduledAction) {
+		if diags := applyInstancePowerSchedule(ctx, d, meta, d.Timeout(schema.TimeoutUpdate)); diags != nil {
+			return diags
+		}
+	}
+
+	return resourceIBMPIInstancePowerScheduleRead(ctx, d, meta)
+}
+
+func resourceIBMPIInstancePowerScheduleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The instance is left in its current power state
+	d.SetId("")
+	return nil
+}
+
+// resourceIBMPIInstancePowerScheduleCustomizeDiff plans the action the power schedule calls for
+// now, so that an apply run after a window starts or stops powers the instance on or off.
+func resourceIBMPIInstancePowerScheduleCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown(Arg_PowerSchedule) {
+		return diff.SetNewComputed(Attr_ScheduledAction)
+	}
+	action, err := powerScheduleAction(diff.Get(Arg_PowerSchedule).([]interface{}), time.Now())
+	if err != nil {
+		return err
+	}
+	if diff.Id() == "" || diff.Get(Attr_ScheduledAction).(string) != action {
+		return diff.SetNew(Attr_ScheduledAction, action)
+	}
+	return nil
+}
+
+func applyInstancePowerSchedule(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	instanceID := d.Get(Arg_PVMInstanceId).(string)
+
+	// The planned action is performed, so a saved plan applied after a window starts or stops
+	// does the action it showed. It is only unknown when the schedule was unknown at plan time.
+	action := d.Get(Attr_ScheduledAction).(string)
+	if action == "" {
+		action, err = powerScheduleAction(d.Get(Arg_PowerSchedule).([]interface{}), time.Now())
+		if err != nil {
+			return piDiagFromErr(err)
+		}
+	}
+
+	log.Printf("[DEBUG] power schedule of instance %s calls for action %s", instanceID, action)
+	client := st.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
+	if err := performInstanceAction(ctx, client, instanceID, action, Health_OK, timeout); err != nil {
+		return piDiagFromErr(err)
+	}
+	d.Set(Attr_ScheduledAction, action)
+
+	return nil
+}
@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMPIInstancePowerSchedule(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// A window that started this minute and stops once a year
+				Config: testAccCheckIBMPIInstancePowerScheduleConfig("* * * * *", "0 0 1 1 *"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_pi_instance_power_schedule.example", "scheduled_action", "start"),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_instance_power_schedule.example", "status", "ACTIVE"),
+				),
+			},
+			{
+				// A window that stopped this minute
+				Config: testAccCheckIBMPIInstancePowerScheduleConfig("0 0 1 1 *", "* * * * *"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_pi_instance_power_schedule.example", "scheduled_action", "stop"),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_instance_power_schedule.example", "status", "SHUTOFF"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIInstancePowerScheduleConfig(start, stop string) string {
+	return fmt.Sprintf(`
+	resource "ibm_pi_instance_power_schedule" "example" {
+		pi_cloud_instance_id	= "%s"
+		pi_instance_id			= "%s"
+		pi_power_schedule {
+			start		= "%s"
+			stop		= "%s"
+			time_zone	= "Europe/Berlin"
+		}
+	}
+	`, acc.Pi_cloud_instance_id, acc.Pi_instance_name, start, stop)
+}
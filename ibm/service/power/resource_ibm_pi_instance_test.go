@@ -443,6 +443,128 @@ func TestAccIBMPIInstanceNetwork(t *testing.T) {
 	})
 }
 
+func TestAccIBMPIInstanceNetworkHealthCheck(t *testing.T) {
+	instanceRes := "ibm_pi_instance.power_instance"
+	name := fmt.Sprintf("tf-pi-instance-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMPIInstanceNetworkHealthCheckConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIInstanceExists(instanceRes),
+					resource.TestCheckResourceAttr(instanceRes, "pi_network_health_check", "true"),
+					resource.TestCheckResourceAttrSet(instanceRes, "pi_network.0.ip_address"),
+					resource.TestCheckResourceAttrSet(instanceRes, "pi_network.0.mac_address"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIBMPIInstanceNetworkHealthCheckConfig(name string) string {
+	return fmt.Sprintf(`
+	data "ibm_pi_network" "power_networks" {
+		pi_cloud_instance_id = "%[1]s"
+		pi_network_name      = "%[3]s"
+	}
+	resource "ibm_pi_instance" "power_instance" {
+		pi_memory               = "2"
+		pi_processors           = "0.25"
+		pi_instance_name        = "%[2]s"
+		pi_proc_type            = "shared"
+		pi_image_id             = "%[4]s"
+		pi_sys_type             = "s922"
+		pi_storage_type         = "tier3"
+		pi_cloud_instance_id    = "%[1]s"
+		pi_network_health_check = true
+		pi_network {
+			network_id = data.ibm_pi_network.power_networks.id
+		}
+	}
+	`, acc.Pi_cloud_instance_id, name, acc.Pi_network_name, acc.Pi_image)
+}
+
+func TestAccIBMPIInstanceNetworkUpdate(t *testing.T) {
+	instanceRes := "ibm_pi_instance.power_instance"
+	name := fmt.Sprintf("tf-pi-instance-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMPIInstanceNetworkUpdateConfig(name, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIInstanceExists(instanceRes),
+					resource.TestCheckResourceAttr(instanceRes, "pi_network.#", "1"),
+				),
+			},
+			{
+				// Attach a second network in place
+				Config: testAccIBMPIInstanceNetworkUpdateConfig(name, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIInstanceExists(instanceRes),
+					resource.TestCheckResourceAttr(instanceRes, "pi_network.#", "2"),
+					resource.TestCheckResourceAttr(instanceRes, "pi_network.1.ip_address", "192.168.18.253"),
+				),
+			},
+			{
+				// Detach the first network in place
+				Config: testAccIBMPIInstanceNetworkUpdateConfig(name, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIInstanceExists(instanceRes),
+					resource.TestCheckResourceAttr(instanceRes, "pi_network.#", "1"),
+					resource.TestCheckResourceAttr(instanceRes, "pi_network.0.ip_address", "192.168.18.253"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIBMPIInstanceNetworkUpdateConfig(name string, first, second bool) string {
+	var networks string
+	if first {
+		networks += `
+		pi_network {
+			network_id = data.ibm_pi_network.power_networks.id
+		}`
+	}
+	if second {
+		networks += `
+		pi_network {
+			network_id = ibm_pi_network.second_network.network_id
+			ip_address = "192.168.18.253"
+		}`
+	}
+	return fmt.Sprintf(`
+	data "ibm_pi_network" "power_networks" {
+		pi_cloud_instance_id = "%[1]s"
+		pi_network_name      = "%[3]s"
+	}
+	resource "ibm_pi_network" "second_network" {
+		pi_cloud_instance_id = "%[1]s"
+		pi_network_name      = "%[2]s"
+		pi_network_type      = "vlan"
+		pi_cidr              = "192.168.18.0/24"
+	}
+	resource "ibm_pi_instance" "power_instance" {
+		pi_memory             = "2"
+		pi_processors         = "0.25"
+		pi_instance_name      = "%[2]s"
+		pi_proc_type          = "shared"
+		pi_image_id           = "%[4]s"
+		pi_sys_type           = "s922"
+		pi_storage_type       = "tier3"
+		pi_cloud_instance_id  = "%[1]s"
+		%[5]s
+	}
+	`, acc.Pi_cloud_instance_id, name, acc.Pi_network_name, acc.Pi_image, networks)
+}
+
 func TestAccIBMPIInstanceVTL(t *testing.T) {
 	instanceRes := "ibm_pi_instance.vtl_instance"
 	name := fmt.Sprintf("tf-pi-vtl-instance-%d", acctest.RandIntRange(10, 100))
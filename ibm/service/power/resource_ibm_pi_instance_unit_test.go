@@ -0,0 +1,592 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/helpers"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/power/internal/powertest"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testPIInstance(id, status, health string) *models.PVMInstance {
+	name := "vm-" + id
+	return &models.PVMInstance{
+		Health:        powertest.Health(health),
+		PvmInstanceID: &id,
+		ServerName:    &name,
+		Status:        &status,
+	}
+}
+
+func TestPIInstanceRefreshFunc(t *testing.T) {
+	server := powertest.NewServer(t)
+	client := instance.NewIBMPIInstanceClient(context.Background(), server.Session(t), powertest.CloudInstanceID)
+
+	failed := testPIInstance("failed", "ERROR", "CRITICAL")
+	failed.Fault = &models.PVMInstanceFault{Message: "no valid host was found"}
+	server.AddInstance(testPIInstance("building", "BUILD", "PENDING"))
+	server.AddInstance(testPIInstance("active", "ACTIVE", Health_OK))
+	server.AddInstance(testPIInstance("warning", "ACTIVE", Health_Warning))
+	server.AddInstance(failed)
+
+	tests := []struct {
+		id          string
+		readyStatus string
+		wantState   string
+		wantErr     string
+	}{
+		{id: "building", readyStatus: Health_OK, wantState: helpers.PIInstanceBuilding},
+		{id: "active", readyStatus: Health_OK, wantState: helpers.PIInstanceAvailable},
+		{id: "warning", readyStatus: Health_OK, wantState: helpers.PIInstanceBuilding},
+		{id: "warning", readyStatus: Health_Warning, wantState: helpers.PIInstanceAvailable},
+		{id: "failed", readyStatus: Health_OK, wantState: "ERROR", wantErr: "no valid host was found"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.id+"/"+tc.readyStatus, func(t *testing.T) {
+			_, state, err := isPIInstanceRefreshFunc(client, tc.id, tc.readyStatus)()
+			if state != tc.wantState {
+				t.Errorf("state = %q, want %q", state, tc.wantState)
+			}
+			if tc.wantErr == "" && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if tc.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tc.wantErr)) {
+				t.Errorf("error = %v, want it to contain %q", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestPIInstanceDeleteRefreshFunc(t *testing.T) {
+	server := powertest.NewServer(t)
+	client := instance.NewIBMPIInstanceClient(context.Background(), server.Session(t), powertest.CloudInstanceID)
+	deleteRequested := time.Now()
+
+	newFault := testPIInstance("new-fault", "ERROR", "CRITICAL")
+	newFault.Fault = &models.PVMInstanceFault{Created: strfmt.DateTime(deleteRequested.Add(time.Minute)), Message: "volume detach failed"}
+	oldFault := testPIInstance("old-fault", "ERROR", "CRITICAL")
+	oldFault.Fault = &models.PVMInstanceFault{Created: strfmt.DateTime(deleteRequested.Add(-time.Hour)), Message: "no valid host was found"}
+	server.AddInstance(testPIInstance("deleting", "DELETING", Health_OK))
+	server.AddInstance(newFault)
+	server.AddInstance(oldFault)
+	server.Fail("GET", "pvm-instances/unavailable", 503, "service unavailable")
+	server.Fail("GET", "pvm-instances/forbidden", 403, "not authorized")
+
+	tests := []struct {
+		id        string
+		wantState string
+		wantErr   string
+	}{
+		{id: "deleting", wantState: helpers.PIInstanceDeleting},
+		{id: "deleted", wantState: helpers.PIInstanceNotFound},
+		{id: "new-fault", wantState: "ERROR", wantErr: "volume detach failed"},
+		{id: "old-fault", wantState: helpers.PIInstanceDeleting},
+		{id: "unavailable", wantState: "retry"},
+		{id: "forbidden", wantErr: "not authorized"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.id, func(t *testing.T) {
+			_, state, err := isPIInstanceDeleteRefreshFunc(client, tc.id, deleteRequested)()
+			if state != tc.wantState {
+				t.Errorf("state = %q, want %q", state, tc.wantState)
+			}
+			if tc.wantErr == "" && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if tc.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tc.wantErr)) {
+				t.Errorf("error = %v, want it to contain %q", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestShutdownIBMPIInstanceForDelete(t *testing.T) {
+	server := powertest.NewServer(t)
+	client := instance.NewIBMPIInstanceClient(context.Background(), server.Session(t), powertest.CloudInstanceID)
+	server.AddInstance(testPIInstance("shutoff", StatusShutoff, Health_OK))
+	server.AddInstance(testPIInstance("busy", "ACTIVE", Health_OK))
+	server.Fail("POST", "pvm-instances/busy/action", 409, "the instance is busy")
+
+	if err := shutdownIBMPIInstanceForDelete(context.Background(), client, "shutoff", "stop", time.Minute); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := shutdownIBMPIInstanceForDelete(context.Background(), client, "busy", "immediate-shutdown", time.Minute); err == nil || piReasonCode(err) != Reason_Conflict {
+		t.Errorf("error = %v, want a conflict", err)
+	}
+
+	want := []string{
+		"GET pvm-instances/shutoff",
+		"GET pvm-instances/busy",
+		"POST pvm-instances/busy/action",
+	}
+	if got := server.Requests(); strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("requests = %q, want %q", got, want)
+	}
+}
+
+func TestDeleteIBMPIInstanceWithDataVolumes(t *testing.T) {
+	server := powertest.NewServer(t)
+	server.AddInstance(testPIInstance("instance", "ACTIVE", Health_OK))
+
+	err := deleteIBMPIInstanceWithDataVolumes(context.Background(), server.Session(t), powertest.CloudInstanceID, "instance")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if server.Instance("instance") != nil {
+		t.Error("the instance was not deleted")
+	}
+	if got, want := server.Requests(), "DELETE pvm-instances/instance?delete_data_volumes=true"; len(got) != 1 || got[0] != want {
+		t.Errorf("requests = %q, want [%q]", got, want)
+	}
+}
+
+func TestValidateIBMPISAPProfileChange(t *testing.T) {
+	server := powertest.NewServer(t)
+	client := instance.NewIBMPISAPInstanceClient(context.Background(), server.Session(t), powertest.CloudInstanceID)
+	server.AddSAPProfile(testPISAPProfile("bh1-22x1100", models.SAPProfileTypeBalanced, 22, 1100, "NetWeaver"))
+
+	tests := []struct {
+		profileID string
+		sysType   string
+		wantErr   string
+	}{
+		{profileID: "bh1-22x1100", sysType: "e980"},
+		{profileID: "bh1-22x1100", sysType: "s922", wantErr: "does not support the system type s922 of the lpar, it supports e980, e1080"},
+		{profileID: "bh1-22x1100"},
+		{profileID: "bh1-9x450", sysType: "e980", wantErr: "failed to get the sap profile bh1-9x450"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.profileID+"/"+tc.sysType, func(t *testing.T) {
+			err := validateIBMPISAPProfileChange(client, tc.profileID, tc.sysType)
+			if tc.wantErr == "" && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if tc.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tc.wantErr)) {
+				t.Errorf("error = %v, want it to contain %q", err, tc.wantErr)
+			}
+		})
+	}
+	if got := server.Requests(); len(got) != len(tests) {
+		t.Errorf("requests = %q, want one profile get per validation", got)
+	}
+}
+
+func TestDetachIBMPIInstanceVirtualOpticalDevice(t *testing.T) {
+	server := powertest.NewServer(t)
+	client := instance.NewIBMPIInstanceClient(context.Background(), server.Session(t), powertest.CloudInstanceID)
+	server.AddInstance(testPIInstance("instance", "ACTIVE", Health_OK))
+	server.Fail("PUT", "pvm-instances/busy", 409, "the instance is busy")
+
+	if err := detachIBMPIInstanceVirtualOpticalDevice(client, "instance"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	err := detachIBMPIInstanceVirtualOpticalDevice(client, "busy")
+	if err == nil || !strings.Contains(err.Error(), "failed to detach the virtual optical device of the lpar busy") || piReasonCode(err) != Reason_Conflict {
+		t.Errorf("error = %v, want a conflict detaching the device", err)
+	}
+
+	want := []string{
+		"PUT pvm-instances/instance detach",
+		"PUT pvm-instances/busy",
+	}
+	if got := server.Requests(); strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("requests = %q, want %q", got, want)
+	}
+}
+
+func TestPartialIBMPIInstanceCreateError(t *testing.T) {
+	pvms := models.PVMInstanceList{
+		testPIInstance("id-1", "ACTIVE", Health_OK),
+		testPIInstance("id-2", "BUILD", "PENDING"),
+		testPIInstance("id-3", "BUILD", "PENDING"),
+	}
+
+	err := partialIBMPIInstanceCreateError(context.DeadlineExceeded, pvms, 1)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("error %v does not wrap the wait error", err)
+	}
+	for _, want := range []string{"create interrupted", "vm-id-1 (id-1, ready)", "vm-id-2 (id-2, not ready)", "vm-id-3 (id-3, not ready)"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not contain %q", err, want)
+		}
+	}
+}
+
+func TestAddIBMPIInstanceSSHKeysToUserData(t *testing.T) {
+	keys := []string{"ssh-rsa AAAAB3Nza admin@example.com", "ssh-ed25519 AAAAC3Nza ops"}
+	wantConfig := "#cloud-config\nssh_authorized_keys:\n  - \"ssh-rsa AAAAB3Nza admin@example.com\"\n  - \"ssh-ed25519 AAAAC3Nza ops\"\n"
+
+	t.Run("no user data", func(t *testing.T) {
+		userData, err := addIBMPIInstanceSSHKeysToUserData("", keys)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if decoded, _ := base64.StdEncoding.DecodeString(userData); string(decoded) != wantConfig {
+			t.Errorf("user data = %q, want %q", decoded, wantConfig)
+		}
+	})
+
+	script := "#!/bin/bash\necho hello\n"
+	for name, given := range map[string]string{"script": script, "encoded script": base64.StdEncoding.EncodeToString([]byte(script))} {
+		t.Run(name, func(t *testing.T) {
+			userData, err := addIBMPIInstanceSSHKeysToUserData(given, keys)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			decoded, err := base64.StdEncoding.DecodeString(userData)
+			if err != nil {
+				t.Fatalf("the user data is not base64 encoded: %v", err)
+			}
+			msg, err := mail.ReadMessage(strings.NewReader(string(decoded)))
+			if err != nil {
+				t.Fatalf("the user data is not a MIME archive: %v", err)
+			}
+			mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+			if err != nil || mediaType != "multipart/mixed" {
+				t.Fatalf("content type = %q, want multipart/mixed", msg.Header.Get("Content-Type"))
+			}
+
+			want := []struct{ contentType, content string }{
+				{"text/x-shellscript", script},
+				{"text/cloud-config", wantConfig},
+			}
+			r := multipart.NewReader(msg.Body, params["boundary"])
+			for i, w := range want {
+				part, err := r.NextPart()
+				if err != nil {
+					t.Fatalf("part %d: %v", i, err)
+				}
+				content, _ := io.ReadAll(part)
+				if part.Header.Get("Content-Type") != w.contentType || string(content) != w.content {
+					t.Errorf("part %d = %s %q, want %s %q", i, part.Header.Get("Content-Type"), content, w.contentType, w.content)
+				}
+			}
+			if _, err := r.NextPart(); err != io.EOF {
+				t.Errorf("unexpected part after the cloud-config: %v", err)
+			}
+		})
+	}
+
+	t.Run("unknown format", func(t *testing.T) {
+		if _, err := addIBMPIInstanceSSHKeysToUserData("STRSBS SBSD(QINTER)", keys); err == nil || !strings.Contains(err.Error(), Arg_SSHKeys) {
+			t.Errorf("error = %v, want an error about %s", err, Arg_SSHKeys)
+		}
+	})
+}
+
+func TestGetIBMPIInstancePlacement(t *testing.T) {
+	server := powertest.NewServer(t)
+	sess := server.Session(t)
+	client := instance.NewIBMPIInstanceClient(context.Background(), sess, powertest.CloudInstanceID)
+	pgClient := instance.NewIBMPIPlacementGroupClient(context.Background(), sess, powertest.CloudInstanceID)
+
+	for id, hostID := range map[string]int64{"a": 1, "b": 2, "c": 2, "unknown": 0} {
+		pvm := testPIInstance(id, "ACTIVE", Health_OK)
+		pvm.HostID = hostID
+		server.AddInstance(pvm)
+	}
+
+	tests := []struct {
+		name    string
+		policy  string
+		members []string
+		want    bool
+	}{
+		{name: "anti-affinity separated", policy: models.PlacementGroupPolicyAntiDashAffinity, members: []string{"a", "b"}, want: true},
+		{name: "anti-affinity same host", policy: models.PlacementGroupPolicyAntiDashAffinity, members: []string{"a", "b", "c"}},
+		{name: "affinity same host", policy: models.PlacementGroupPolicyAffinity, members: []string{"b", "c"}, want: true},
+		{name: "affinity separated", policy: models.PlacementGroupPolicyAffinity, members: []string{"a", "b"}},
+		{name: "host unknown", policy: models.PlacementGroupPolicyAntiDashAffinity, members: []string{"a", "unknown"}},
+		{name: "member not listed", policy: models.PlacementGroupPolicyAffinity, members: []string{"b", "deleted"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			id, policy := tc.name, tc.policy
+			server.AddPlacementGroup(&models.PlacementGroup{ID: &id, Members: tc.members, Policy: &policy})
+
+			before := len(server.Requests())
+			members, satisfied, err := getIBMPIInstancePlacement(client, pgClient, id)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if requests := server.Requests()[before:]; len(requests) != 2 {
+				t.Errorf("requests = %v, want the placement group and the list of instances", requests)
+			}
+			if satisfied != tc.want {
+				t.Errorf("satisfied = %t, want %t", satisfied, tc.want)
+			}
+			if len(members) != len(tc.members) {
+				t.Errorf("members = %v, want %d members", members, len(tc.members))
+			}
+		})
+	}
+
+	t.Run("placement group not found", func(t *testing.T) {
+		if _, _, err := getIBMPIInstancePlacement(client, pgClient, "missing"); err == nil {
+			t.Error("expected an error for a missing placement group")
+		}
+	})
+}
+
+// noPIInstanceWaitDelay removes the delay of the instance waiters for the test, since the mock
+// completes the operations right away.
+func noPIInstanceWaitDelay(t *testing.T) {
+	delay := piInstanceWaitDelay
+	piInstanceWaitDelay = func(time.Duration) time.Duration { return 0 }
+	t.Cleanup(func() { piInstanceWaitDelay = delay })
+}
+
+// testPIInstanceUpdateData returns the data of an update of the memory of the instance
+// "instance", from 4 GB with a maximum of 8 GB in the given status.
+func testPIInstanceUpdateData(t *testing.T, status string, memory float64) *schema.ResourceData {
+	t.Helper()
+	sm := schema.InternalMap(ResourceIBMPIInstance().Schema)
+	config := func(memory float64) map[string]interface{} {
+		return map[string]interface{}{
+			helpers.PICloudInstanceId:    powertest.CloudInstanceID,
+			helpers.PIInstanceImageId:    "image",
+			helpers.PIInstanceMemory:     memory,
+			helpers.PIInstanceName:       "vm-instance",
+			helpers.PIInstanceProcessors: 1.0,
+			helpers.PIInstanceProcType:   "shared",
+			PIInstanceNetwork:            []interface{}{map[string]interface{}{"network_id": "network"}},
+		}
+	}
+
+	current := schema.TestResourceDataRaw(t, sm, config(4))
+	current.SetId(powertest.CloudInstanceID + "/instance")
+	current.Set("health_status", Health_OK)
+	current.Set("max_memory", 8.0)
+	current.Set("max_processors", 2.0)
+	current.Set("status", status)
+	state := current.State()
+
+	diff, err := sm.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config(memory)), nil, nil, true)
+	if err != nil {
+		t.Fatalf("failed to plan the update: %v", err)
+	}
+	d, err := sm.Data(state, diff)
+	if err != nil {
+		t.Fatalf("failed to plan the update: %v", err)
+	}
+	return d
+}
+
+func TestResourceIBMPIInstanceUpdateMemory(t *testing.T) {
+	noPIInstanceWaitDelay(t)
+
+	tests := []struct {
+		name   string
+		status string
+		memory float64
+		want   []string
+	}{
+		{
+			name:   "above maximum",
+			status: "ACTIVE",
+			memory: 16,
+			want: []string{
+				"POST pvm-instances/instance/action immediate-shutdown",
+				"GET pvm-instances/instance",
+				"PUT pvm-instances/instance",
+				"GET pvm-instances/instance",
+				"POST pvm-instances/instance/action start",
+				"GET pvm-instances/instance",
+			},
+		},
+		{
+			name:   "within maximum",
+			status: "ACTIVE",
+			memory: 6,
+			want: []string{
+				"PUT pvm-instances/instance",
+				"GET pvm-instances/instance",
+			},
+		},
+		{
+			name:   "shut off",
+			status: StatusShutoff,
+			memory: 16,
+			want: []string{
+				"PUT pvm-instances/instance",
+				"GET pvm-instances/instance",
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := powertest.NewServer(t)
+			pvm := testPIInstance("instance", tc.status, Health_OK)
+			pvm.PlacementGroup = flex.PtrToString("none")
+			server.AddInstance(pvm)
+
+			diags := resourceIBMPIInstanceUpdate(context.Background(), testPIInstanceUpdateData(t, tc.status, tc.memory), server.Meta(t))
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			// The workspace is read for its capabilities first, and the instance last
+			want := append(append([]string{"GET"}, tc.want...), "GET pvm-instances/instance")
+			if got := server.Requests(); strings.Join(got, "\n") != strings.Join(want, "\n") {
+				t.Errorf("requests = %q, want %q", got, want)
+			}
+			pvm = server.Instance("instance")
+			if pvm.Memory == nil || *pvm.Memory != tc.memory {
+				t.Errorf("memory = %v, want %v", pvm.Memory, tc.memory)
+			}
+			if *pvm.Status != tc.status {
+				t.Errorf("status = %s, want %s", *pvm.Status, tc.status)
+			}
+		})
+	}
+}
+
+func TestUpdatePVMNetworks(t *testing.T) {
+	noPIInstanceWaitDelay(t)
+
+	current := []*models.PVMInstanceNetwork{
+		{IPAddress: "10.0.0.5", MacAddress: "fa:16:3e:00:01:01", NetworkID: "a"},
+		{IPAddress: "10.0.1.5", MacAddress: "fa:16:3e:00:01:02", NetworkID: "b"},
+		{IPAddress: "10.0.1.6", MacAddress: "fa:16:3e:00:01:03", NetworkID: "b"},
+	}
+	tests := []struct {
+		name     string
+		desired  []*models.PVMInstanceAddNetwork
+		want     []string
+		wantMACs []string
+	}{
+		{
+			name: "unchanged in another order",
+			desired: []*models.PVMInstanceAddNetwork{
+				{NetworkID: flex.PtrToString("b"), IPAddress: "10.0.1.6"},
+				{NetworkID: flex.PtrToString("b")},
+				{NetworkID: flex.PtrToString("a")},
+			},
+			wantMACs: []string{"fa:16:3e:00:01:01", "fa:16:3e:00:01:02", "fa:16:3e:00:01:03"},
+		},
+		{
+			name: "removed before an added one",
+			desired: []*models.PVMInstanceAddNetwork{
+				{NetworkID: flex.PtrToString("b")},
+				{NetworkID: flex.PtrToString("b")},
+				{NetworkID: flex.PtrToString("c"), IPAddress: "10.0.2.5"},
+			},
+			want: []string{
+				"DELETE pvm-instances/instance/networks/a",
+				"POST pvm-instances/instance/networks",
+			},
+			wantMACs: []string{"fa:16:3e:00:01:02", "fa:16:3e:00:01:03", "fa:16:3e:00:00:01"},
+		},
+		{
+			name: "one of the same network removed",
+			desired: []*models.PVMInstanceAddNetwork{
+				{NetworkID: flex.PtrToString("a")},
+				{NetworkID: flex.PtrToString("b"), IPAddress: "10.0.1.6"},
+			},
+			want:     []string{"DELETE pvm-instances/instance/networks/b"},
+			wantMACs: []string{"fa:16:3e:00:01:01", "fa:16:3e:00:01:03"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := powertest.NewServer(t)
+			client := instance.NewIBMPIInstanceClient(context.Background(), server.Session(t), powertest.CloudInstanceID)
+			pvm := testPIInstance("instance", "ACTIVE", Health_OK)
+			state := []interface{}{}
+			for _, n := range current {
+				network := *n
+				pvm.Networks = append(pvm.Networks, &network)
+				state = append(state, map[string]interface{}{
+					"ip_address":  n.IPAddress,
+					"mac_address": n.MacAddress,
+					"network_id":  n.NetworkID,
+				})
+			}
+			server.AddInstance(pvm)
+
+			if err := updatePVMNetworks(context.Background(), client, "instance", state, tc.desired, time.Minute); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			// The networks are checked once all of them are attached and detached
+			want := append(tc.want, "GET pvm-instances/instance")
+			if got := server.Requests(); strings.Join(got, "\n") != strings.Join(want, "\n") {
+				t.Errorf("requests = %q, want %q", got, want)
+			}
+			var macs []string
+			for _, n := range server.Instance("instance").Networks {
+				macs = append(macs, n.MacAddress)
+			}
+			if strings.Join(macs, " ") != strings.Join(tc.wantMACs, " ") {
+				t.Errorf("networks = %q, want %q", macs, tc.wantMACs)
+			}
+		})
+	}
+}
+
+func TestUpdateIBMPIInstanceVirtualOpticalDevice(t *testing.T) {
+	noPIInstanceWaitDelay(t)
+
+	tests := []struct {
+		name   string
+		vod    string
+		detach bool
+		want   []string
+	}{
+		{
+			name: "attach",
+			vod:  models.CloudInitializationVirtualOpticalDeviceAttach,
+			want: []string{"PUT pvm-instances/instance attach"},
+		},
+		{
+			// The instance booted before the device was attached, so it is restarted to boot
+			// with the device before it is detached
+			name:   "attach and detach",
+			vod:    models.CloudInitializationVirtualOpticalDeviceAttach,
+			detach: true,
+			want: []string{
+				"PUT pvm-instances/instance attach",
+				"GET pvm-instances/instance",
+				"POST pvm-instances/instance/action stop",
+				"GET pvm-instances/instance",
+				"GET pvm-instances/instance",
+				"POST pvm-instances/instance/action start",
+				"GET pvm-instances/instance",
+				"GET pvm-instances/instance",
+				"PUT pvm-instances/instance detach",
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := powertest.NewServer(t)
+			client := instance.NewIBMPIInstanceClient(context.Background(), server.Session(t), powertest.CloudInstanceID)
+			server.AddInstance(testPIInstance("instance", "ACTIVE", Health_OK))
+
+			err := updateIBMPIInstanceVirtualOpticalDevice(context.Background(), client, "instance", tc.vod, tc.detach, Health_OK, time.Minute)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := server.Requests(); strings.Join(got, "\n") != strings.Join(tc.want, "\n") {
+				t.Errorf("requests = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
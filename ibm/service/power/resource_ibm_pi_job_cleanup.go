@@ -0,0 +1,122 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMPIJobCleanup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPIJobCleanupCreate,
+		ReadContext:   resourceIBMPIJobCleanupRead,
+		DeleteContext: resourceIBMPIJobCleanupDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_CloudInstanceID: {
+				Description:  "The GUID of the service instance associated with an account.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_JobStates: {
+				Description: "The states of the jobs to delete. Defaults to completed and failed.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{State_Completed, State_Failed}, false),
+				},
+				ForceNew: true,
+				Optional: true,
+				Type:     schema.TypeSet,
+			},
+			Arg_OperationAction: {
+				Description:  "Only delete jobs of this operation action, for example vmCapture or imageExport.",
+				ForceNew:     true,
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// Attributes
+			Attr_DeletedJobIDs: {
+				Computed:    true,
+				Description: "The IDs of the jobs that were deleted.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Type:        schema.TypeList,
+			},
+		},
+	}
+}
+
+func resourceIBMPIJobCleanupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	action := d.Get(Arg_OperationAction).(string)
+	states := []string{State_Completed, State_Failed}
+	if v, ok := d.GetOk(Arg_JobStates); ok {
+		states = flex.ExpandStringList(v.(*schema.Set).List())
+	}
+
+	client := instance.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
+	jobs, err := client.GetAll()
+	if err != nil {
+		log.Printf("[ERROR] get all jobs failed %v", err)
+		return piDiagFromErr(err)
+	}
+
+	deleted := []string{}
+	var errs []error
+	for _, state := range states {
+		for _, job := range filterJobs(jobs.Jobs, state, action, "") {
+			if err := client.Delete(*job.ID); err != nil {
+				errs = append(errs, fmt.Errorf("failed to delete job %s: %v", *job.ID, err))
+				continue
+			}
+			deleted = append(deleted, *job.ID)
+		}
+	}
+	log.Printf("[DEBUG] deleted %d jobs in cloud instance %s", len(deleted), cloudInstanceID)
+	if len(errs) > 0 {
+		return piDiagFromErr(errors.Join(errs...))
+	}
+
+	genID, _ := uuid.GenerateUUID()
+	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, genID))
+	d.Set(Attr_DeletedJobIDs, deleted)
+
+	return resourceIBMPIJobCleanupRead(ctx, d, meta)
+}
+
+func resourceIBMPIJobCleanupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// There is no get concept for a job cleanup
+	return nil
+}
+
+func resourceIBMPIJobCleanupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Deleted jobs cannot be restored
+	d.SetId("")
+	return nil
+}
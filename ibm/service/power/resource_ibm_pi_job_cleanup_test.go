@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMPIJobCleanupBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIJobCleanupConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_pi_job_cleanup.cleanup", "id"),
+					resource.TestCheckResourceAttrSet("ibm_pi_job_cleanup.cleanup", "deleted_job_ids.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIJobCleanupConfig() string {
+	return fmt.Sprintf(`
+		resource "ibm_pi_job_cleanup" "cleanup" {
+			pi_cloud_instance_id = "%[1]s"
+			pi_job_states        = ["completed", "failed"]
+		}`, acc.Pi_cloud_instance_id)
+}
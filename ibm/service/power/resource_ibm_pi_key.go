@@ -12,6 +12,7 @@ import (
 	"github.com/IBM-Cloud/power-go-client/clients/instance"
 	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -45,8 +46,18 @@ func ResourceIBMPIKey() *schema.Resource {
 				ValidateFunc: validation.NoZeroValues,
 			},
 			Arg_SSHKey: {
+				Computed:     true,
 				Description:  "SSH RSA key.",
-				Required:     true,
+				ExactlyOneOf: []string{Arg_SSHKey, Arg_VPCKeyID},
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_VPCKeyID: {
+				Description:  "ID of an account-level VPC SSH key whose public key is imported into the workspace.",
+				ExactlyOneOf: []string{Arg_SSHKey, Arg_VPCKeyID},
+				ForceNew:     true,
+				Optional:     true,
 				Type:         schema.TypeString,
 				ValidateFunc: validation.NoZeroValues,
 			},
@@ -75,13 +86,19 @@ func resourceIBMPIKeyCreate(ctx context.Context, d *schema.ResourceData, meta in
 	// session
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	// arguments
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
 	name := d.Get(Arg_KeyName).(string)
 	sshkey := d.Get(Arg_SSHKey).(string)
+	if vpcKeyID, ok := d.GetOk(Arg_VPCKeyID); ok {
+		sshkey, err = getVPCPublicKey(ctx, meta, vpcKeyID.(string))
+		if err != nil {
+			return piDiagFromErr(err)
+		}
+	}
 
 	// create key
 	client := instance.NewIBMPIKeyClient(ctx, sess, cloudInstanceID)
@@ -92,11 +109,12 @@ func resourceIBMPIKeyCreate(ctx context.Context, d *schema.ResourceData, meta in
 	sshResponse, err := client.Create(body)
 	if err != nil {
 		log.Printf("[DEBUG]  err %s", err)
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	log.Printf("Printing the sshkey %+v", *sshResponse)
 	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, name))
+	d.Set(Arg_SSHKey, sshkey)
 	return resourceIBMPIKeyRead(ctx, d, meta)
 }
 
@@ -104,20 +122,20 @@ func resourceIBMPIKeyRead(ctx context.Context, d *schema.ResourceData, meta inte
 	// session
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	// arguments
 	cloudInstanceID, key, err := splitID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	// get key
 	sshkeyC := instance.NewIBMPIKeyClient(ctx, sess, cloudInstanceID)
 	sshkeydata, err := sshkeyC.Get(key)
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	// set attributes
@@ -136,21 +154,37 @@ func resourceIBMPIKeyDelete(ctx context.Context, d *schema.ResourceData, meta in
 	// session
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	// arguments
 	cloudInstanceID, key, err := splitID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	// delete key
 	sshkeyC := instance.NewIBMPIKeyClient(ctx, sess, cloudInstanceID)
 	err = sshkeyC.Delete(key)
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	d.SetId("")
 	return nil
 }
+
+// getVPCPublicKey returns the public key of an account-level VPC SSH key in the provider region.
+func getVPCPublicKey(ctx context.Context, meta interface{}, id string) (string, error) {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		return "", err
+	}
+	key, response, err := vpcClient.GetKeyWithContext(ctx, &vpcv1.GetKeyOptions{ID: &id})
+	if err != nil {
+		return "", fmt.Errorf("failed to get VPC SSH key %s: %w\n%s", id, err, response)
+	}
+	if key.PublicKey == nil {
+		return "", fmt.Errorf("VPC SSH key %s has no public key", id)
+	}
+	return *key.PublicKey, nil
+}
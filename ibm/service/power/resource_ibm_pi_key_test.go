@@ -41,6 +41,27 @@ ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVE
 	})
 }
 
+func TestAccIBMPIKeyVPCKey(t *testing.T) {
+	publicKey := strings.TrimSpace(`
+ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVERRN7/9484SOBJ3HSKxxNG5JN8owAjy5f9yYwcUg+JaUVuytn5Pv3aeYROHGGg+5G346xaq3DAwX6Y5ykr2fvjObgncQBnuU5KHWCECO/4h8uWuwh/kfniXPVjFToc+gnkqA+3RKpAecZhFXwfalQ9mMuYGFxn+fwn8cYEApsJbsEmb0iJwPiZ5hjFC8wREuiTlhPHDgkBLOiycd20op2nXzDbHfCHInquEe/gYxEitALONxm0swBOwJZwlTDOB7C6y2dzlrtxr1L59m7pCkWI4EtTRLvleehBoj3u7jB4usR
+`)
+	name := fmt.Sprintf("tf-pi-sshkey-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIKeyVPCKeyConfig(publicKey, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIKeyExists("ibm_pi_key.key"),
+					resource.TestCheckResourceAttrPair("ibm_pi_key.key", "ssh_key", "ibm_is_ssh_key.key", "public_key"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMPIKeyDestroy(s *terraform.State) error {
 	sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
 	if err != nil {
@@ -103,3 +124,16 @@ func testAccCheckIBMPIKeyConfig(publicKey, name string) string {
 			pi_ssh_key           = "%s"
 		  }`, acc.Pi_cloud_instance_id, name, publicKey)
 }
+
+func testAccCheckIBMPIKeyVPCKeyConfig(publicKey, name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_is_ssh_key" "key" {
+			name       = "%[2]s"
+			public_key = "%[3]s"
+		}
+		resource "ibm_pi_key" "key" {
+			pi_cloud_instance_id = "%[1]s"
+			pi_key_name          = "%[2]s"
+			pi_vpc_key_id        = ibm_is_ssh_key.key.id
+		}`, acc.Pi_cloud_instance_id, name, publicKey)
+}
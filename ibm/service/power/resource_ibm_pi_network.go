@@ -140,7 +140,7 @@ func ResourceIBMPINetwork() *schema.Resource {
 func resourceIBMPINetworkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	cloudInstanceID := d.Get(helpers.PICloudInstanceId).(string)
 	networkname := d.Get(helpers.PINetworkName).(string)
@@ -180,7 +180,7 @@ func resourceIBMPINetworkCreate(ctx context.Context, d *schema.ResourceData, met
 
 		gateway, firstip, lastip, err := generateIPData(networkcidr)
 		if err != nil {
-			return diag.FromErr(err)
+			return piDiagFromErr(err)
 		}
 
 		ipBodyRanges = []*models.IPAddressRange{{EndingIPAddress: &lastip, StartingIPAddress: &firstip}}
@@ -200,7 +200,7 @@ func resourceIBMPINetworkCreate(ctx context.Context, d *schema.ResourceData, met
 
 	networkResponse, err := client.Create(body)
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	networkID := *networkResponse.NetworkID
@@ -209,7 +209,7 @@ func resourceIBMPINetworkCreate(ctx context.Context, d *schema.ResourceData, met
 
 	_, err = isWaitForIBMPINetworkAvailable(ctx, client, networkID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	return resourceIBMPINetworkRead(ctx, d, meta)
@@ -218,18 +218,18 @@ func resourceIBMPINetworkCreate(ctx context.Context, d *schema.ResourceData, met
 func resourceIBMPINetworkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID, networkID, err := splitID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	networkC := st.NewIBMPINetworkClient(ctx, sess, cloudInstanceID)
 	networkdata, err := networkC.Get(networkID)
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	d.Set("network_id", networkdata.NetworkID)
@@ -263,12 +263,12 @@ func resourceIBMPINetworkRead(ctx context.Context, d *schema.ResourceData, meta
 func resourceIBMPINetworkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID, networkID, err := splitID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	if d.HasChanges(helpers.PINetworkName, helpers.PINetworkDNS, helpers.PINetworkGateway, helpers.PINetworkIPAddressRange) {
@@ -287,7 +287,7 @@ func resourceIBMPINetworkUpdate(ctx context.Context, d *schema.ResourceData, met
 
 		_, err = networkC.Update(networkID, body)
 		if err != nil {
-			return diag.FromErr(err)
+			return piDiagFromErr(err)
 		}
 	}
 
@@ -299,19 +299,19 @@ func resourceIBMPINetworkDelete(ctx context.Context, d *schema.ResourceData, met
 	log.Printf("Calling the network delete functions. ")
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID, networkID, err := splitID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	networkC := st.NewIBMPINetworkClient(ctx, sess, cloudInstanceID)
 	err = networkC.Delete(networkID)
 
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	d.SetId("")
 	return nil
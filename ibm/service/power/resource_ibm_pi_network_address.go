@@ -0,0 +1,261 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/helpers"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceIBMPINetworkAddress reserves an IP address of a subnet as a network port that is not
+// attached to an instance, or that is attached to the instance given in pi_instance_id.
+func ResourceIBMPINetworkAddress() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPINetworkAddressCreate,
+		ReadContext:   resourceIBMPINetworkAddressRead,
+		UpdateContext: resourceIBMPINetworkAddressUpdate,
+		DeleteContext: resourceIBMPINetworkAddressDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_CloudInstanceID: {
+				Description:  "The GUID of the service instance associated with an account.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_Description: {
+				Default:     "IP address reserved via Terraform",
+				Description: "The description of the reservation.",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			Arg_IPAddress: {
+				Computed:     true,
+				Description:  "The IP address to reserve. If not set, the next free address of the subnet is reserved.",
+				ForceNew:     true,
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			Arg_NetworkName: {
+				Description:  "The unique identifier or name of the network to reserve the address in.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_PVMInstanceId: {
+				Description:  "The ID of the instance to attach the reserved address to.",
+				ForceNew:     true,
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// Attributes
+			Attr_ExternalIP: {
+				Computed:    true,
+				Description: "The public IP address associated with the reserved address.",
+				Type:        schema.TypeString,
+			},
+			Attr_Href: {
+				Computed:    true,
+				Description: "The href of the network port holding the reservation.",
+				Type:        schema.TypeString,
+			},
+			Attr_MacAddress: {
+				Computed:    true,
+				Description: "The MAC address of the network port holding the reservation.",
+				Type:        schema.TypeString,
+			},
+			Attr_NetworkAddressID: {
+				Computed:    true,
+				Description: "The ID of the network port holding the reservation.",
+				Type:        schema.TypeString,
+			},
+			Attr_PVMInstanceID: {
+				Computed:    true,
+				Description: "The ID of the instance the reserved address is attached to, if any.",
+				Type:        schema.TypeString,
+			},
+			Attr_Status: {
+				Computed:    true,
+				Description: "The status of the network port holding the reservation; DOWN while it is not attached to an instance.",
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func resourceIBMPINetworkAddressCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	networkName := d.Get(Arg_NetworkName).(string)
+	description := d.Get(Arg_Description).(string)
+	client := instance.NewIBMPINetworkClient(ctx, sess, cloudInstanceID)
+
+	body := &models.NetworkPortCreate{Description: description}
+	if v, ok := d.GetOk(Arg_IPAddress); ok {
+		body.IPAddress = v.(string)
+	}
+	port, err := client.CreatePort(networkName, body)
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+	portID := *port.PortID
+	d.SetId(fmt.Sprintf("%s/%s/%s", cloudInstanceID, networkName, portID))
+
+	_, err = isWaitForIBMPINetworkAddressReserved(ctx, client, portID, networkName, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+
+	if v, ok := d.GetOk(Arg_PVMInstanceId); ok {
+		instanceID := v.(string)
+		_, err = client.UpdatePort(networkName, portID, &models.NetworkPortUpdate{
+			Description:   &description,
+			PvmInstanceID: &instanceID,
+		})
+		if err != nil {
+			return piDiagFromErr(err)
+		}
+		_, err = isWaitForIBMPINetworkPortAttachAvailable(ctx, client, portID, networkName, instanceID, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return piDiagFromErr(err)
+		}
+	}
+
+	return resourceIBMPINetworkAddressRead(ctx, d, meta)
+}
+
+func resourceIBMPINetworkAddressRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+
+	parts, err := splitIDParts(d.Id(), "cloud_instance_id", "network_name", "port_id")
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+	cloudInstanceID, networkName, portID := parts[0], parts[1], parts[2]
+
+	client := instance.NewIBMPINetworkClient(ctx, sess, cloudInstanceID)
+	port, err := client.GetPort(networkName, portID)
+	if err != nil {
+		if strings.Contains(strings.ToLower(err.Error()), NotFound) {
+			log.Printf("[DEBUG] network address %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return piDiagFromErr(err)
+	}
+
+	d.Set(Arg_CloudInstanceID, cloudInstanceID)
+	d.Set(Arg_Description, port.Description)
+	d.Set(Arg_IPAddress, port.IPAddress)
+	d.Set(Arg_NetworkName, networkName)
+	d.Set(Attr_ExternalIP, port.ExternalIP)
+	d.Set(Attr_Href, port.Href)
+	d.Set(Attr_MacAddress, port.MacAddress)
+	d.Set(Attr_NetworkAddressID, port.PortID)
+	d.Set(Attr_Status, port.Status)
+
+	pvmInstanceID := ""
+	if port.PvmInstance != nil {
+		pvmInstanceID = port.PvmInstance.PvmInstanceID
+	}
+	d.Set(Attr_PVMInstanceID, pvmInstanceID)
+	if _, ok := d.GetOk(Arg_PVMInstanceId); ok {
+		d.Set(Arg_PVMInstanceId, pvmInstanceID)
+	}
+
+	return nil
+}
+
+func resourceIBMPINetworkAddressUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+
+	parts, err := splitIDParts(d.Id(), "cloud_instance_id", "network_name", "port_id")
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+	cloudInstanceID, networkName, portID := parts[0], parts[1], parts[2]
+
+	if d.HasChange(Arg_Description) {
+		client := instance.NewIBMPINetworkClient(ctx, sess, cloudInstanceID)
+		description := d.Get(Arg_Description).(string)
+		_, err = client.UpdatePort(networkName, portID, &models.NetworkPortUpdate{Description: &description})
+		if err != nil {
+			return piDiagFromErr(err)
+		}
+	}
+
+	return resourceIBMPINetworkAddressRead(ctx, d, meta)
+}
+
+func resourceIBMPINetworkAddressDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+
+	parts, err := splitIDParts(d.Id(), "cloud_instance_id", "network_name", "port_id")
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+	cloudInstanceID, networkName, portID := parts[0], parts[1], parts[2]
+
+	client := instance.NewIBMPINetworkClient(ctx, sess, cloudInstanceID)
+	err = client.DeletePort(networkName, portID)
+	if err != nil && !strings.Contains(strings.ToLower(err.Error()), NotFound) {
+		return piDiagFromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func isWaitForIBMPINetworkAddressReserved(ctx context.Context, client *instance.IBMPINetworkClient, id, networkName string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for network address (%s) of network (%s) to be reserved.", id, networkName)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{State_Retry, helpers.PINetworkProvisioning},
+		Target:     []string{"DOWN"},
+		Refresh:    isIBMPINetworkportRefreshFunc(client, id, networkName),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+)
+
+func TestAccIBMPINetworkAddressBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-network-address-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPINetworkAddressDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPINetworkAddressConfig(name, "reserved"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPINetworkAddressExists("ibm_pi_network_address.address"),
+					resource.TestCheckResourceAttr("ibm_pi_network_address.address", "pi_ip_address", "192.168.17.100"),
+					resource.TestCheckResourceAttr("ibm_pi_network_address.address", "status", "DOWN"),
+					resource.TestCheckResourceAttrSet("ibm_pi_network_address.address", "network_address_id"),
+					resource.TestCheckResourceAttrSet("ibm_pi_network_address.address", "macaddress"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPINetworkAddressConfig(name, "reserved for database"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPINetworkAddressExists("ibm_pi_network_address.address"),
+					resource.TestCheckResourceAttr("ibm_pi_network_address.address", "pi_description", "reserved for database"),
+				),
+			},
+			{
+				ResourceName:      "ibm_pi_network_address.address",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPINetworkAddressDestroy(s *terraform.State) error {
+	sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_pi_network_address" {
+			continue
+		}
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		client := st.NewIBMPINetworkClient(context.Background(), sess, parts[0])
+		_, err = client.GetPort(parts[1], parts[2])
+		if err == nil {
+			return fmt.Errorf("PI network address still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMPINetworkAddressExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return errors.New("No Record ID is set")
+		}
+
+		sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
+		if err != nil {
+			return err
+		}
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		client := st.NewIBMPINetworkClient(context.Background(), sess, parts[0])
+		_, err = client.GetPort(parts[1], parts[2])
+		return err
+	}
+}
+
+func testAccCheckIBMPINetworkAddressConfig(name, description string) string {
+	return testAccCheckIBMPINetworkGatewayConfig(name) + fmt.Sprintf(`
+	resource "ibm_pi_network_address" "address" {
+		pi_cloud_instance_id = "%s"
+		pi_description       = "%s"
+		pi_ip_address        = "192.168.17.100"
+		pi_network_name      = ibm_pi_network.power_networks.pi_network_name
+	}
+	`, acc.Pi_cloud_instance_id, description)
+}
@@ -16,7 +16,6 @@ import (
 	"github.com/IBM-Cloud/power-go-client/helpers"
 	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
-	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -89,7 +88,7 @@ func ResourceIBMPINetworkPortAttach() *schema.Resource {
 func resourceIBMPINetworkPortAttachCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	cloudInstanceID := d.Get(helpers.PICloudInstanceId).(string)
 	networkname := d.Get(helpers.PINetworkName).(string)
@@ -111,7 +110,7 @@ func resourceIBMPINetworkPortAttachCreate(ctx context.Context, d *schema.Resourc
 
 	networkPortResponse, err := client.CreatePort(networkname, nwportBody)
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	log.Printf("Printing the networkresponse %+v", &networkPortResponse)
@@ -120,17 +119,25 @@ func resourceIBMPINetworkPortAttachCreate(ctx context.Context, d *schema.Resourc
 
 	_, err = isWaitForIBMPINetworkportAvailable(ctx, client, networkPortID, networkname, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	networkPortResponse, err = client.UpdatePort(networkname, networkPortID, nwportattachBody)
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
-	_, err = isWaitForIBMPINetworkPortAttachAvailable(ctx, client, networkPortID, networkname, instanceID, d.Timeout(schema.TimeoutUpdate))
+	port, err := isWaitForIBMPINetworkPortAttachAvailable(ctx, client, networkPortID, networkname, instanceID, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
+	}
+
+	// The network list of the instance can lag behind the port attachment, so wait for the port to
+	// show up there before dependent resources read the instance.
+	instanceClient := st.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
+	_, err = isWaitForIBMPIInstanceNetworkPortAvailable(ctx, instanceClient, instanceID, port.(*models.NetworkPort), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return piDiagFromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", cloudInstanceID, networkname, networkPortID))
@@ -141,12 +148,12 @@ func resourceIBMPINetworkPortAttachCreate(ctx context.Context, d *schema.Resourc
 func resourceIBMPINetworkPortAttachRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
-	parts, err := flex.IdParts(d.Id())
+	parts, err := splitIDParts(d.Id(), "cloud_instance_id", "network_name", "port_id")
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	cloudInstanceID := parts[0]
 	networkname := parts[1]
@@ -155,7 +162,7 @@ func resourceIBMPINetworkPortAttachRead(ctx context.Context, d *schema.ResourceD
 	networkC := st.NewIBMPINetworkClient(ctx, sess, cloudInstanceID)
 	networkdata, err := networkC.GetPort(networkname, portID)
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	d.Set(helpers.PINetworkPortIPAddress, networkdata.IPAddress)
@@ -174,12 +181,12 @@ func resourceIBMPINetworkPortAttachDelete(ctx context.Context, d *schema.Resourc
 	log.Printf("Calling the network delete functions. ")
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
-	parts, err := flex.IdParts(d.Id())
+	parts, err := splitIDParts(d.Id(), "cloud_instance_id", "network_name", "port_id")
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	cloudInstanceID := parts[0]
 	networkname := parts[1]
@@ -190,7 +197,7 @@ func resourceIBMPINetworkPortAttachDelete(ctx context.Context, d *schema.Resourc
 	log.Printf("Calling the delete with the following params delete with cloud instance (%s) and networkid (%s) and portid (%s) ", cloudInstanceID, networkname, portID)
 	err = client.DeletePort(networkname, portID)
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	d.SetId("")
@@ -261,3 +268,39 @@ func isIBMPINetworkPortAttachRefreshFunc(client *st.IBMPINetworkClient, id, netw
 		return network, helpers.PINetworkProvisioning, nil
 	}
 }
+
+func isWaitForIBMPIInstanceNetworkPortAvailable(ctx context.Context, client *st.IBMPIInstanceClient, instanceID string, port *models.NetworkPort, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for network port (%s) to be available in the networks of instance (%s).", *port.PortID, instanceID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{State_Retry, State_Pending},
+		Target:     []string{State_Available},
+		Refresh:    isIBMPIInstanceNetworkPortRefreshFunc(client, instanceID, port),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isIBMPIInstanceNetworkPortRefreshFunc(client *st.IBMPIInstanceClient, instanceID string, port *models.NetworkPort) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		pvm, err := client.Get(instanceID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, network := range pvm.Networks {
+			if network == nil {
+				continue
+			}
+			if (port.IPAddress != nil && *port.IPAddress != "" && network.IPAddress == *port.IPAddress) ||
+				(port.MacAddress != nil && *port.MacAddress != "" && network.MacAddress == *port.MacAddress) {
+				return pvm, State_Available, nil
+			}
+		}
+
+		return pvm, State_Pending, nil
+	}
+}
@@ -12,7 +12,6 @@ import (
 	"github.com/IBM-Cloud/power-go-client/clients/instance"
 	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
-	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -73,7 +72,7 @@ func ResourceIBMPIPlacementGroup() *schema.Resource {
 func resourceIBMPIPlacementGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
@@ -87,7 +86,7 @@ func resourceIBMPIPlacementGroupCreate(ctx context.Context, d *schema.ResourceDa
 
 	response, err := client.Create(body)
 	if err != nil || response == nil {
-		return diag.FromErr(fmt.Errorf("error creating the shared processor pool: %s", err))
+		return piDiagFromErr(fmt.Errorf("error creating the shared processor pool: %s", err))
 	}
 
 	log.Printf("Printing the placement group %+v", &response)
@@ -99,11 +98,11 @@ func resourceIBMPIPlacementGroupCreate(ctx context.Context, d *schema.ResourceDa
 func resourceIBMPIPlacementGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
-	parts, err := flex.IdParts(d.Id())
+	parts, err := splitIDParts(d.Id(), "cloud_instance_id", "placement_group_id")
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := parts[0]
@@ -112,7 +111,7 @@ func resourceIBMPIPlacementGroupRead(ctx context.Context, d *schema.ResourceData
 	response, err := client.Get(parts[1])
 	if err != nil {
 		log.Printf("[DEBUG]  err %s", err)
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	d.Set(Arg_PlacementGroupName, response.Name)
@@ -130,18 +129,18 @@ func resourceIBMPIPlacementGroupUpdate(ctx context.Context, d *schema.ResourceDa
 func resourceIBMPIPlacementGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
-	parts, err := flex.IdParts(d.Id())
+	parts, err := splitIDParts(d.Id(), "cloud_instance_id", "placement_group_id")
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	cloudInstanceID := parts[0]
 	client := instance.NewIBMPIPlacementGroupClient(ctx, sess, cloudInstanceID)
 	err = client.Delete(parts[1])
 
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	d.SetId("")
 	return nil
@@ -14,10 +14,10 @@ import (
 	"github.com/IBM-Cloud/power-go-client/helpers"
 	models "github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
-	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func ResourceIBMPISharedProcessorPool() *schema.Resource {
@@ -50,9 +50,10 @@ func ResourceIBMPISharedProcessorPool() *schema.Resource {
 			},
 
 			Arg_SharedProcessorPoolReservedCores: {
-				Type:        schema.TypeInt,
-				Required:    true,
-				Description: "The amount of reserved cores for the shared processor pool",
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  "The amount of reserved cores for the shared processor pool",
+				ValidateFunc: validation.IntAtLeast(1),
 			},
 
 			Arg_CloudInstanceID: {
@@ -81,7 +82,7 @@ func ResourceIBMPISharedProcessorPool() *schema.Resource {
 			},
 
 			Attr_SharedProcessorPoolAvailableCores: {
-				Type:        schema.TypeInt,
+				Type:        schema.TypeFloat,
 				Computed:    true,
 				Description: "Shared processor pool available cores",
 			},
@@ -173,7 +174,7 @@ func ResourceIBMPISharedProcessorPool() *schema.Resource {
 func resourceIBMPISharedProcessorPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(helpers.PICloudInstanceId).(string)
@@ -199,45 +200,43 @@ func resourceIBMPISharedProcessorPoolCreate(ctx context.Context, d *schema.Resou
 		return diag.Errorf("error creating the shared processor pool: %v", err)
 	}
 
-	var sharedProcessorPoolReadyStatus string
 	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, *spp.ID))
-	_, err = isWaitForPISharedProcessorPoolAvailable(ctx, d, client, *spp.ID, sharedProcessorPoolReadyStatus)
+	_, err = isWaitForPISharedProcessorPoolAvailable(ctx, client, *spp.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	return resourceIBMPISharedProcessorPoolRead(ctx, d, meta)
 
 }
 
-func isWaitForPISharedProcessorPoolAvailable(ctx context.Context, d *schema.ResourceData, client *st.IBMPISharedProcessorPoolClient, id string, sharedProcessorPoolReadyStatus string) (interface{}, error) {
+func isWaitForPISharedProcessorPoolAvailable(ctx context.Context, client *st.IBMPISharedProcessorPoolClient, id string, timeout time.Duration) (interface{}, error) {
 	log.Printf("Waiting for PISharedProcessorPool (%s) to be active ", id)
 
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{"configuring"},
 		Target:     []string{"active", "failed", ""},
-		Refresh:    isPISharedProcessorPoolRefreshFunc(client, id, sharedProcessorPoolReadyStatus),
+		Refresh:    isPISharedProcessorPoolRefreshFunc(client, id),
 		Delay:      20 * time.Second,
 		MinTimeout: activeTimeOut,
-		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Timeout:    timeout,
 	}
 
 	return stateConf.WaitForStateContext(ctx)
 }
 
-func isPISharedProcessorPoolRefreshFunc(client *st.IBMPISharedProcessorPoolClient, id, sharedProcessorPoolReadyStatus string) resource.StateRefreshFunc {
+func isPISharedProcessorPoolRefreshFunc(client *st.IBMPISharedProcessorPoolClient, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 
 		pool, err := client.Get(id)
 		if err != nil {
 			return nil, "", err
 		}
-		// Check for `sharedProcessorPoolReadyStatus` status
 		if pool.SharedProcessorPool.Status == "active" {
 			return pool, "active", nil
 		}
 		if pool.SharedProcessorPool.Status == "failed" {
-			err = fmt.Errorf("failed to create the shared processor pool")
+			err = fmt.Errorf("the shared processor pool %s failed: %s", id, pool.SharedProcessorPool.StatusDetail)
 			return pool, pool.SharedProcessorPool.Status, err
 		}
 
@@ -249,11 +248,11 @@ func resourceIBMPISharedProcessorPoolRead(ctx context.Context, d *schema.Resourc
 
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
-	parts, err := flex.IdParts(d.Id())
+	parts, err := splitIDParts(d.Id(), "cloud_instance_id", "shared_processor_pool_id")
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := parts[0]
@@ -282,9 +281,6 @@ func resourceIBMPISharedProcessorPoolRead(ctx context.Context, d *schema.Resourc
 	if response.SharedProcessorPool.AvailableCores != nil {
 		d.Set(Attr_SharedProcessorPoolAvailableCores, response.SharedProcessorPool.AvailableCores)
 	}
-	if response.SharedProcessorPool.AvailableCores != nil {
-		d.Set(Attr_SharedProcessorPoolAvailableCores, response.SharedProcessorPool.AvailableCores)
-	}
 	if response.SharedProcessorPool.SharedProcessorPoolPlacementGroups != nil {
 		pgIDs := make([]string, len(response.SharedProcessorPool.SharedProcessorPoolPlacementGroups))
 		for i, pg := range response.SharedProcessorPool.SharedProcessorPoolPlacementGroups {
@@ -322,29 +318,46 @@ func resourceIBMPISharedProcessorPoolRead(ctx context.Context, d *schema.Resourc
 func resourceIBMPISharedProcessorPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID, sppID, err := splitID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	client := st.NewIBMPISharedProcessorPoolClient(ctx, sess, cloudInstanceID)
-	body := &models.SharedProcessorPoolUpdate{}
 
-	if d.HasChange(Arg_SharedProcessorPoolName) {
-		name := d.Get(Arg_SharedProcessorPoolName).(string)
-		body.Name = name
-	}
-	if d.HasChange(Arg_SharedProcessorPoolReservedCores) {
-		reservedCores := int64(d.Get(Arg_SharedProcessorPoolReservedCores).(int))
-		body.ReservedCores = &reservedCores
-	}
+	if d.HasChanges(Arg_SharedProcessorPoolName, Arg_SharedProcessorPoolReservedCores) {
+		body := &models.SharedProcessorPoolUpdate{}
+		if d.HasChange(Arg_SharedProcessorPoolName) {
+			name := d.Get(Arg_SharedProcessorPoolName).(string)
+			body.Name = name
+		}
+		if d.HasChange(Arg_SharedProcessorPoolReservedCores) {
+			reservedCores := int64(d.Get(Arg_SharedProcessorPoolReservedCores).(int))
+			pool, err := client.Get(sppID)
+			if err != nil {
+				return piDiagFromErr(err)
+			}
+			if err := checkIBMPISharedProcessorPoolResize(pool.SharedProcessorPool, reservedCores); err != nil {
+				return piDiagFromErr(err)
+			}
+			body.ReservedCores = &reservedCores
+		}
 
-	_, err = client.Update(sppID, body)
-	if err != nil {
-		return diag.Errorf("error updating the shared processor pool: %v", err)
+		_, err = client.Update(sppID, body)
+		if err != nil {
+			return piDiagFromErr(fmt.Errorf("error updating the shared processor pool: %w", err))
+		}
+
+		// A resize puts the pool back into configuring while the cores are moved.
+		if body.ReservedCores != nil {
+			_, err = isWaitForPISharedProcessorPoolAvailable(ctx, client, sppID, d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return piDiagFromErr(err)
+			}
+		}
 	}
 
 	if d.HasChange(Attr_SharedProcessorPoolPlacementGroups) {
@@ -374,7 +387,7 @@ func resourceIBMPISharedProcessorPoolUpdate(ctx context.Context, d *schema.Resou
 				if err != nil {
 					// ignore delete member error where the spp is already not in the PG
 					if !strings.Contains(err.Error(), "is not part of spp placement group") {
-						return diag.FromErr(err)
+						return piDiagFromErr(err)
 					}
 				}
 			}
@@ -389,7 +402,7 @@ func resourceIBMPISharedProcessorPoolUpdate(ctx context.Context, d *schema.Resou
 				// add spp to a new placement group
 				_, err := pgClient.AddMember(placementGroupID, sppID)
 				if err != nil {
-					return diag.FromErr(err)
+					return piDiagFromErr(err)
 				}
 			}
 		}
@@ -398,6 +411,18 @@ func resourceIBMPISharedProcessorPoolUpdate(ctx context.Context, d *schema.Resou
 	return resourceIBMPISharedProcessorPoolRead(ctx, d, meta)
 }
 
+// checkIBMPISharedProcessorPoolResize returns an error when the pool cannot be
+// resized to reservedCores because its instances already use more cores.
+func checkIBMPISharedProcessorPoolResize(pool *models.SharedProcessorPool, reservedCores int64) error {
+	if pool == nil || pool.AllocatedCores == nil {
+		return nil
+	}
+	if float64(reservedCores) < *pool.AllocatedCores {
+		return fmt.Errorf("cannot resize the shared processor pool to %d reserved cores: %g cores are allocated to its instances", reservedCores, *pool.AllocatedCores)
+	}
+	return nil
+}
+
 // returns the elements in string array a that are not in array z
 func getDifferences(a, z []string) []string {
 	mb := make(map[string]struct{}, len(z))
@@ -416,11 +441,11 @@ func getDifferences(a, z []string) []string {
 func resourceIBMPISharedProcessorPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
-	parts, err := flex.IdParts(d.Id())
+	parts, err := splitIDParts(d.Id(), "cloud_instance_id", "shared_processor_pool_id")
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	cloudInstanceID := parts[0]
 	client := st.NewIBMPISharedProcessorPoolClient(ctx, sess, cloudInstanceID)
@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+)
+
+func TestCheckIBMPISharedProcessorPoolResize(t *testing.T) {
+	allocated := 2.5
+	pool := &models.SharedProcessorPool{AllocatedCores: &allocated}
+
+	tests := []struct {
+		name          string
+		pool          *models.SharedProcessorPool
+		reservedCores int64
+		wantErr       string
+	}{
+		{name: "grow", pool: pool, reservedCores: 4},
+		{name: "shrink to fit", pool: pool, reservedCores: 3},
+		{name: "shrink below allocated", pool: pool, reservedCores: 2, wantErr: "2.5 cores are allocated"},
+		{name: "unknown allocation", pool: &models.SharedProcessorPool{}, reservedCores: 1},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkIBMPISharedProcessorPoolResize(tc.pool, tc.reservedCores)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("error = %v, want it to contain %q", err, tc.wantErr)
+			}
+		})
+	}
+}
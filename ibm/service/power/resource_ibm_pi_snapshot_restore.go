@@ -0,0 +1,212 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_snapshots"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMPISnapshotRestore() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPISnapshotRestoreCreate,
+		ReadContext:   resourceIBMPISnapshotRestoreRead,
+		DeleteContext: resourceIBMPISnapshotRestoreDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_CloudInstanceID: {
+				Description:  "The GUID of the service instance associated with an account.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_Force: {
+				Default:     false,
+				Description: "Indicates if the restore runs without the instance being shut off first.",
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
+			Arg_PVMInstanceId: {
+				Description:  "The ID of the PVM instance to restore.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_RestoreFailAction: {
+				Default:      "retry",
+				Description:  "Action to take on a failed snapshot restore, retry or rollback.",
+				ForceNew:     true,
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"retry", "rollback"}),
+			},
+			Arg_SnapshotID: {
+				Description:  "The ID of the PVM instance snapshot to restore.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// Attributes
+			Attr_RestoredVolumeIDs: {
+				Computed:    true,
+				Description: "The IDs of the volumes that were restored from the snapshot.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Type:        schema.TypeList,
+			},
+			Attr_Status: {
+				Computed:    true,
+				Description: "Status of the PVM instance snapshot.",
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func resourceIBMPISnapshotRestoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	instanceID := d.Get(Arg_PVMInstanceId).(string)
+	snapshotID := d.Get(Arg_SnapshotID).(string)
+	restoreFailAction := d.Get(Arg_RestoreFailAction).(string)
+	force := d.Get(Arg_Force).(bool)
+
+	client := instance.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
+	pvm, err := client.Get(instanceID)
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+	_, err = client.RestoreSnapShotVM(instanceID, snapshotID, restoreFailAction, &models.SnapshotRestore{Force: &force})
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", cloudInstanceID, instanceID, snapshotID))
+
+	snapshotClient := instance.NewIBMPISnapshotClient(ctx, sess, cloudInstanceID)
+	_, err = isWaitForPIInstanceSnapshotRestored(ctx, snapshotClient, client, instanceID, snapshotID, flex.StringValue(pvm.Status), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+
+	return resourceIBMPISnapshotRestoreRead(ctx, d, meta)
+}
+
+func resourceIBMPISnapshotRestoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+
+	ids, err := splitIDParts(d.Id(), "cloud_instance_id", "instance_id", "snapshot_id")
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+	cloudInstanceID, snapshotID := ids[0], ids[2]
+
+	client := instance.NewIBMPISnapshotClient(ctx, sess, cloudInstanceID)
+	snapshot, err := client.Get(snapshotID)
+	if err != nil {
+		// The restore happened; deleting the snapshot afterwards does not undo it
+		if _, ok := errors.Unwrap(err).(*p_cloud_snapshots.PcloudCloudinstancesSnapshotsGetNotFound); ok {
+			log.Printf("[DEBUG] snapshot %s of restore %s no longer exists", snapshotID, d.Id())
+			return nil
+		}
+		return piDiagFromErr(err)
+	}
+
+	volumeIDs := make([]string, 0, len(snapshot.VolumeSnapshots))
+	for volumeID := range snapshot.VolumeSnapshots {
+		volumeIDs = append(volumeIDs, volumeID)
+	}
+	sort.Strings(volumeIDs)
+
+	d.Set(Attr_RestoredVolumeIDs, volumeIDs)
+	d.Set(Attr_Status, snapshot.Status)
+
+	return nil
+}
+
+func resourceIBMPISnapshotRestoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// A restore cannot be undone
+	d.SetId("")
+	return nil
+}
+
+func isWaitForPIInstanceSnapshotRestored(ctx context.Context, client *instance.IBMPISnapshotClient, instanceClient *instance.IBMPIInstanceClient, instanceID, id, instanceStatus string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for PIInstance Snapshot (%s) restore to complete", id)
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{State_InProgress},
+		Target:     []string{State_Available},
+		Refresh:    isPIInstanceSnapshotRestoreRefreshFunc(client, instanceClient, instanceID, id, instanceStatus),
+		Delay:      30 * time.Second,
+		MinTimeout: 30 * time.Second,
+		Timeout:    timeout,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+// isPIInstanceSnapshotRestoreRefreshFunc reports the restore in progress until it was seen running,
+// because an idle snapshot is available at 100 percent before the restore starts as well. The
+// restore runs once the snapshot leaves that state or the instance leaves the status it had
+// before the restore, and it is done once both are back at rest.
+func isPIInstanceSnapshotRestoreRefreshFunc(client *instance.IBMPISnapshotClient, instanceClient *instance.IBMPIInstanceClient, instanceID, id, instanceStatus string) retry.StateRefreshFunc {
+	started := false
+	return func() (interface{}, string, error) {
+		snapshot, err := client.Get(id)
+		if err != nil {
+			return nil, "", err
+		}
+		if snapshot.Status == State_Error {
+			return snapshot, snapshot.Status, fmt.Errorf("restore of snapshot %s failed", id)
+		}
+
+		pvm, err := instanceClient.Get(instanceID)
+		if err != nil {
+			return nil, "", err
+		}
+		status := flex.StringValue(pvm.Status)
+		if status == StatusError {
+			return snapshot, State_Error, fmt.Errorf("restore of snapshot %s failed, instance %s is in %s state", id, instanceID, status)
+		}
+
+		idle := snapshot.Status == State_Available && snapshot.PercentComplete == 100
+		if !idle || status != instanceStatus {
+			started = true
+		}
+		if started && idle && (status == StatusActive || status == StatusShutoff) {
+			return snapshot, State_Available, nil
+		}
+		return snapshot, State_InProgress, nil
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMPISnapshotRestoreBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-instance-snapshot-%d", acctest.RandIntRange(10, 100))
+	restoreRes := "ibm_pi_snapshot_restore.power_snapshot_restore"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIInstanceSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPISnapshotRestoreConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(restoreRes, "id"),
+					resource.TestCheckResourceAttr(restoreRes, "status", "available"),
+					resource.TestCheckResourceAttr(restoreRes, "restored_volume_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPISnapshotRestoreConfig(name string) string {
+	return testAccCheckIBMPIInstanceSnapshotConfig(name, "OK") + fmt.Sprintf(`
+		resource "ibm_pi_snapshot_restore" "power_snapshot_restore" {
+			pi_cloud_instance_id = "%s"
+			pi_force             = true
+			pi_instance_id       = ibm_pi_instance.power_instance.instance_id
+			pi_snapshot_id       = ibm_pi_snapshot.power_snapshot.snapshot_id
+		}`, acc.Pi_cloud_instance_id)
+}
@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"testing"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/power/internal/powertest"
+)
+
+func TestIsPIInstanceSnapshotRestoreRefreshFunc(t *testing.T) {
+	type poll struct {
+		snapshotStatus  string
+		percentComplete int64
+		instanceStatus  string
+		want            string
+	}
+	tests := []struct {
+		name  string
+		polls []poll
+	}{
+		{
+			name: "snapshot restoring",
+			polls: []poll{
+				{snapshotStatus: State_Available, percentComplete: 100, instanceStatus: StatusActive, want: State_InProgress},
+				{snapshotStatus: "restoring", percentComplete: 40, instanceStatus: StatusActive, want: State_InProgress},
+				{snapshotStatus: State_Available, percentComplete: 100, instanceStatus: StatusActive, want: State_Available},
+			},
+		},
+		{
+			name: "instance restoring",
+			polls: []poll{
+				{snapshotStatus: State_Available, percentComplete: 100, instanceStatus: StatusActive, want: State_InProgress},
+				{snapshotStatus: State_Available, percentComplete: 100, instanceStatus: "RESTORING", want: State_InProgress},
+				{snapshotStatus: State_Available, percentComplete: 100, instanceStatus: StatusActive, want: State_Available},
+			},
+		},
+		{
+			name: "instance shut off",
+			polls: []poll{
+				{snapshotStatus: State_Available, percentComplete: 100, instanceStatus: StatusActive, want: State_InProgress},
+				{snapshotStatus: State_Available, percentComplete: 100, instanceStatus: StatusShutoff, want: State_Available},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := powertest.NewServer(t)
+			server.AddSnapshot(&models.Snapshot{SnapshotID: flex.PtrToString("snapshot")})
+			server.AddInstance(testPIInstance("instance", StatusActive, "OK"))
+			sess := server.Session(t)
+			refresh := isPIInstanceSnapshotRestoreRefreshFunc(
+				instance.NewIBMPISnapshotClient(context.Background(), sess, powertest.CloudInstanceID),
+				instance.NewIBMPIInstanceClient(context.Background(), sess, powertest.CloudInstanceID),
+				"instance", "snapshot", StatusActive)
+
+			for i, p := range tc.polls {
+				server.Snapshot("snapshot").Status = p.snapshotStatus
+				server.Snapshot("snapshot").PercentComplete = p.percentComplete
+				server.Instance("instance").Status = flex.PtrToString(p.instanceStatus)
+				_, state, err := refresh()
+				if err != nil {
+					t.Fatalf("poll %d: %s", i, err)
+				}
+				if state != p.want {
+					t.Errorf("poll %d: state = %q, want %q", i, state, p.want)
+				}
+			}
+		})
+	}
+
+	t.Run("instance error", func(t *testing.T) {
+		server := powertest.NewServer(t)
+		server.AddSnapshot(&models.Snapshot{SnapshotID: flex.PtrToString("snapshot"), Status: State_Available, PercentComplete: 100})
+		server.AddInstance(testPIInstance("instance", StatusError, "CRITICAL"))
+		sess := server.Session(t)
+		refresh := isPIInstanceSnapshotRestoreRefreshFunc(
+			instance.NewIBMPISnapshotClient(context.Background(), sess, powertest.CloudInstanceID),
+			instance.NewIBMPIInstanceClient(context.Background(), sess, powertest.CloudInstanceID),
+			"instance", "snapshot", StatusActive)
+		if _, _, err := refresh(); err == nil {
+			t.Error("expected the refresh to fail")
+		}
+	})
+}
@@ -6,6 +6,7 @@ package power
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -23,11 +24,13 @@ func ResourceIBMPISPPPlacementGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceIBMPISPPPlacementGroupCreate,
 		ReadContext:   resourceIBMPISPPPlacementGroupRead,
+		UpdateContext: resourceIBMPISPPPlacementGroupUpdate,
 		DeleteContext: resourceIBMPISPPPlacementGroupDelete,
 		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
 			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
@@ -55,6 +58,14 @@ func ResourceIBMPISPPPlacementGroup() *schema.Resource {
 				Description: "PI cloud instance ID",
 			},
 
+			Arg_SharedProcessorPoolIDs: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the shared processor pools that are members of the SPP placement group",
+			},
+
 			Attr_SPPPlacementGroupMembers: {
 				Type:        schema.TypeSet,
 				Computed:    true,
@@ -74,7 +85,7 @@ func ResourceIBMPISPPPlacementGroup() *schema.Resource {
 func resourceIBMPISPPPlacementGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(helpers.PICloudInstanceId).(string)
@@ -92,6 +103,15 @@ func resourceIBMPISPPPlacementGroupCreate(ctx context.Context, d *schema.Resourc
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, *response.ID))
+
+	if v, ok := d.GetOk(Arg_SharedProcessorPoolIDs); ok {
+		for _, sppID := range flex.ExpandStringList(v.(*schema.Set).List()) {
+			if _, err := client.AddMember(*response.ID, sppID); err != nil {
+				return diag.Errorf("error adding shared processor pool %s to the spp placement group: %v", sppID, err)
+			}
+		}
+	}
+
 	return resourceIBMPISPPPlacementGroupRead(ctx, d, meta)
 }
 
@@ -99,11 +119,11 @@ func resourceIBMPISPPPlacementGroupRead(ctx context.Context, d *schema.ResourceD
 
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
-	parts, err := flex.IdParts(d.Id())
+	parts, err := splitIDParts(d.Id(), "cloud_instance_id", "spp_placement_group_id")
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := parts[0]
@@ -119,19 +139,56 @@ func resourceIBMPISPPPlacementGroupRead(ctx context.Context, d *schema.ResourceD
 	d.Set(Attr_SPPPlacementGroupID, response.ID)
 	d.Set(Arg_SPPPlacementGroupPolicy, response.Policy)
 	d.Set(Attr_SPPPlacementGroupMembers, response.MemberSharedProcessorPools)
+	d.Set(Arg_SharedProcessorPoolIDs, response.MemberSharedProcessorPools)
 
 	return nil
 
 }
 
+func resourceIBMPISPPPlacementGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+	parts, err := splitIDParts(d.Id(), "cloud_instance_id", "spp_placement_group_id")
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+	cloudInstanceID, placementGroupID := parts[0], parts[1]
+	client := st.NewIBMPISPPPlacementGroupClient(ctx, sess, cloudInstanceID)
+
+	if d.HasChange(Arg_SharedProcessorPoolIDs) {
+		oldRaw, newRaw := d.GetChange(Arg_SharedProcessorPoolIDs)
+		oldSPPs := flex.ExpandStringList(oldRaw.(*schema.Set).List())
+		newSPPs := flex.ExpandStringList(newRaw.(*schema.Set).List())
+
+		for _, sppID := range getDifferences(oldSPPs, newSPPs) {
+			_, err := client.DeleteMember(placementGroupID, sppID)
+			if err != nil {
+				// ignore delete member error where the spp is already not in the PG
+				if !strings.Contains(err.Error(), "is not part of spp placement group") {
+					return diag.Errorf("error removing shared processor pool %s from the spp placement group: %v", sppID, err)
+				}
+			}
+		}
+		for _, sppID := range getDifferences(newSPPs, oldSPPs) {
+			if _, err := client.AddMember(placementGroupID, sppID); err != nil {
+				return diag.Errorf("error adding shared processor pool %s to the spp placement group: %v", sppID, err)
+			}
+		}
+	}
+
+	return resourceIBMPISPPPlacementGroupRead(ctx, d, meta)
+}
+
 func resourceIBMPISPPPlacementGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
-	parts, err := flex.IdParts(d.Id())
+	parts, err := splitIDParts(d.Id(), "cloud_instance_id", "spp_placement_group_id")
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	cloudInstanceID := parts[0]
 	client := st.NewIBMPISPPPlacementGroupClient(ctx, sess, cloudInstanceID)
@@ -664,3 +664,64 @@ func testAccCheckIBMPICreateSAPInstanceWithSPP(name string, policy string, sapPr
 		}
 	`, acc.Pi_cloud_instance_id, name, policy, acc.Pi_image, acc.Pi_network_name, acc.Pi_sap_image, sapProfile)
 }
+
+func TestAccIBMPISPPPlacementGroupManagedMembers(t *testing.T) {
+	name := fmt.Sprintf("tfspp%d", acctest.RandIntRange(10, 100))
+	policy := "anti-affinity"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPISPPPlacementGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPISPPPlacementGroupManagedMembersConfig(name, policy, "ibm_pi_shared_processor_pool.spp_pool.shared_processor_pool_id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPISPPPlacementGroupExists("ibm_pi_spp_placement_group.spp_placement_group"),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_spp_placement_group.spp_placement_group", "pi_shared_processor_pool_ids.#", "1"),
+					testAccCheckIBMPISPPPlacementGroupMemberExists("ibm_pi_spp_placement_group.spp_placement_group", "ibm_pi_shared_processor_pool.spp_pool"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPISPPPlacementGroupManagedMembersConfig(name, policy, "ibm_pi_shared_processor_pool.spp_pool.shared_processor_pool_id, ibm_pi_shared_processor_pool.spp_pool_2.shared_processor_pool_id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_pi_spp_placement_group.spp_placement_group", "pi_shared_processor_pool_ids.#", "2"),
+					testAccCheckIBMPISPPPlacementGroupMemberExists("ibm_pi_spp_placement_group.spp_placement_group", "ibm_pi_shared_processor_pool.spp_pool_2"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPISPPPlacementGroupManagedMembersConfig(name, policy, "ibm_pi_shared_processor_pool.spp_pool_2.shared_processor_pool_id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_pi_spp_placement_group.spp_placement_group", "pi_shared_processor_pool_ids.#", "1"),
+					testAccCheckIBMPISPPPlacementGroupMemberDoesNotExist("ibm_pi_spp_placement_group.spp_placement_group", "ibm_pi_shared_processor_pool.spp_pool"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPISPPPlacementGroupManagedMembersConfig(name string, policy string, members string) string {
+	return fmt.Sprintf(`
+		resource "ibm_pi_shared_processor_pool" "spp_pool" {
+			pi_cloud_instance_id  = "%[1]s"
+			pi_shared_processor_pool_name = "%[2]s"
+			pi_shared_processor_pool_host_group       = "s922"
+			pi_shared_processor_pool_reserved_cores = "1"
+		}
+		resource "ibm_pi_shared_processor_pool" "spp_pool_2" {
+			pi_cloud_instance_id  = "%[1]s"
+			pi_shared_processor_pool_name = "%[2]s2"
+			pi_shared_processor_pool_host_group       = "s922"
+			pi_shared_processor_pool_reserved_cores = "1"
+		}
+		resource "ibm_pi_spp_placement_group" "spp_placement_group" {
+			pi_cloud_instance_id      = "%[1]s"
+			pi_shared_processor_pool_ids  = [%[4]s]
+			pi_spp_placement_group_name   = "%[2]spg"
+			pi_spp_placement_group_policy = "%[3]s"
+		}
+	`, acc.Pi_cloud_instance_id, name, policy, members)
+}
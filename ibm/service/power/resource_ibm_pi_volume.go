@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/power-go-client/clients/instance"
@@ -28,7 +29,14 @@ func ResourceIBMPIVolume() *schema.Resource {
 		ReadContext:   resourceIBMPIVolumeRead,
 		UpdateContext: resourceIBMPIVolumeUpdate,
 		DeleteContext: resourceIBMPIVolumeDelete,
-		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: resourceIBMPIVolumeCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set(Arg_DeleteProtection, false)
+				d.Set(Arg_ForceDetachOnDelete, false)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
@@ -81,6 +89,18 @@ func ResourceIBMPIVolume() *schema.Resource {
 				Type:         schema.TypeString,
 				ValidateFunc: validation.NoZeroValues,
 			},
+			Arg_DeleteProtection: {
+				Default:     false,
+				Description: "Indicates if the volume is protected from deletion. The provider refuses to delete the volume while this is set.",
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
+			Arg_ForceDetachOnDelete: {
+				Default:     false,
+				Description: "Indicates if the volume is detached from all instances before it is deleted.",
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
 			Arg_ReplicationEnabled: {
 				Computed:    true,
 				Description: "Indicates if the volume should be replication enabled or not.",
@@ -213,7 +233,7 @@ func ResourceIBMPIVolumeValidator() *validate.ResourceValidator {
 func resourceIBMPIVolumeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	name := d.Get(Arg_VolumeName).(string)
@@ -239,6 +259,12 @@ func resourceIBMPIVolumeCreate(ctx context.Context, d *schema.ResourceData, meta
 	if v, ok := d.GetOk(Arg_ReplicationEnabled); ok {
 		replicationEnabled := v.(bool)
 		body.ReplicationEnabled = &replicationEnabled
+		if replicationEnabled {
+			capacityClient := instance.NewIBMPIStorageCapacityClient(ctx, sess, cloudInstanceID)
+			if err := checkReplicationPoolCapacity(capacityClient, body.VolumePool, body.DiskType, size); err != nil {
+				return piDiagFromErr(err)
+			}
+		}
 	}
 	if ap, ok := d.GetOk(Arg_AffinityPolicy); ok {
 		policy := ap.(string)
@@ -269,7 +295,7 @@ func resourceIBMPIVolumeCreate(ctx context.Context, d *schema.ResourceData, meta
 	client := instance.NewIBMPIVolumeClient(ctx, sess, cloudInstanceID)
 	vol, err := client.CreateVolume(body)
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	volumeid := *vol.VolumeID
@@ -277,7 +303,7 @@ func resourceIBMPIVolumeCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	_, err = isWaitForIBMPIVolumeAvailable(ctx, client, volumeid, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	return resourceIBMPIVolumeRead(ctx, d, meta)
@@ -286,19 +312,19 @@ func resourceIBMPIVolumeCreate(ctx context.Context, d *schema.ResourceData, meta
 func resourceIBMPIVolumeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID, volumeID, err := splitID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	client := instance.NewIBMPIVolumeClient(ctx, sess, cloudInstanceID)
 
 	vol, err := client.Get(volumeID)
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	d.Set(Arg_CloudInstanceID, cloudInstanceID)
 	if vol.VolumeID != nil {
@@ -335,12 +361,12 @@ func resourceIBMPIVolumeRead(ctx context.Context, d *schema.ResourceData, meta i
 func resourceIBMPIVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID, volumeID, err := splitID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	client := instance.NewIBMPIVolumeClient(ctx, sess, cloudInstanceID)
@@ -358,11 +384,15 @@ func resourceIBMPIVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta
 	}
 	volrequest, err := client.UpdateVolume(volumeID, body)
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
+	}
+	if d.HasChange(Arg_VolumeSize) {
+		_, err = isWaitForIBMPIVolumeResized(ctx, client, *volrequest.VolumeID, size, d.Timeout(schema.TimeoutUpdate))
+	} else {
+		_, err = isWaitForIBMPIVolumeAvailable(ctx, client, *volrequest.VolumeID, d.Timeout(schema.TimeoutUpdate))
 	}
-	_, err = isWaitForIBMPIVolumeAvailable(ctx, client, *volrequest.VolumeID, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	if d.HasChanges(Arg_ReplicationEnabled, Arg_VolumeType) {
@@ -375,11 +405,11 @@ func resourceIBMPIVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 		err = client.VolumeAction(volumeID, &volActionBody)
 		if err != nil {
-			return diag.FromErr(err)
+			return piDiagFromErr(err)
 		}
 		_, err = isWaitForIBMPIVolumeAvailable(ctx, client, volumeID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return piDiagFromErr(err)
 		}
 	}
 
@@ -389,22 +419,44 @@ func resourceIBMPIVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta
 func resourceIBMPIVolumeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID, volumeID, err := splitID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
+	}
+
+	if d.Get(Arg_DeleteProtection).(bool) {
+		return diag.Errorf("volume %s has %s set; set it to false and apply before deleting the volume", volumeID, Arg_DeleteProtection)
 	}
 
 	client := instance.NewIBMPIVolumeClient(ctx, sess, cloudInstanceID)
+	if d.Get(Arg_ForceDetachOnDelete).(bool) {
+		vol, err := client.Get(volumeID)
+		if err != nil {
+			return piDiagFromErr(err)
+		}
+		for _, pvmInstanceID := range vol.PvmInstanceIDs {
+			log.Printf("[DEBUG] detaching volume %s from instance %s before delete", volumeID, pvmInstanceID)
+			err = client.Detach(pvmInstanceID, volumeID)
+			if err != nil {
+				return piDiagFromErr(err)
+			}
+			_, err = isWaitForIBMPIVolumeDetach(ctx, client, volumeID, cloudInstanceID, pvmInstanceID, d.Timeout(schema.TimeoutDelete))
+			if err != nil {
+				return piDiagFromErr(err)
+			}
+		}
+	}
+
 	err = client.DeleteVolume(volumeID)
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	_, err = isWaitForIBMPIVolumeDeleted(ctx, client, volumeID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	d.SetId("")
 	return nil
@@ -440,6 +492,58 @@ func isIBMPIVolumeRefreshFunc(client *instance.IBMPIVolumeClient, id string) ret
 	}
 }
 
+// resourceIBMPIVolumeCustomizeDiff rejects shrinking a volume at plan time; volumes can only be
+// expanded.
+func resourceIBMPIVolumeCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" || !diff.HasChange(Arg_VolumeSize) || !diff.NewValueKnown(Arg_VolumeSize) {
+		return nil
+	}
+	oldSize, newSize := diff.GetChange(Arg_VolumeSize)
+	if newSize.(float64) < oldSize.(float64) {
+		return fmt.Errorf("%s cannot be reduced from %v GB to %v GB: volumes can only be expanded; create a new volume and copy the data to use a smaller one", Arg_VolumeSize, oldSize, newSize)
+	}
+	return nil
+}
+
+// isWaitForIBMPIVolumeResized waits until the volume reports the new size and is available or
+// in use again. Attached volumes, including the boot volume of a running instance, are resized
+// online.
+func isWaitForIBMPIVolumeResized(ctx context.Context, client *instance.IBMPIVolumeClient, id string, size float64, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for Volume (%s) to be resized to %v GB.", id, size)
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{State_Retry, State_Resizing},
+		Target:     []string{State_Available},
+		Refresh:    isIBMPIVolumeResizeRefreshFunc(client, id, size),
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+		Timeout:    timeout,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isIBMPIVolumeResizeRefreshFunc(client *instance.IBMPIVolumeClient, id string, size float64) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		vol, err := client.Get(id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if vol.State == State_Error {
+			return vol, vol.State, fmt.Errorf("resize of volume %s to %v GB failed, the volume is in state %s", id, size, vol.State)
+		}
+		if vol.Size == nil || *vol.Size < size {
+			return vol, State_Resizing, nil
+		}
+		if vol.State == State_Available || vol.State == State_InUse {
+			return vol, State_Available, nil
+		}
+
+		return vol, State_Resizing, nil
+	}
+}
+
 func isWaitForIBMPIVolumeDeleted(ctx context.Context, client *instance.IBMPIVolumeClient, id string, timeout time.Duration) (interface{}, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:    []string{State_Deleting, State_Creating},
@@ -470,3 +574,46 @@ func isIBMPIVolumeDeleteRefreshFunc(client *instance.IBMPIVolumeClient, id strin
 		return vol, State_Deleting, nil
 	}
 }
+
+// checkReplicationPoolCapacity fails fast when no replication enabled storage pool matching the
+// requested pool or storage type has room for a volume of the given size (GB).
+func checkReplicationPoolCapacity(client *instance.IBMPIStorageCapacityClient, pool, storageType string, size float64) error {
+	spc, err := client.GetAllStoragePoolsCapacity()
+	if err != nil {
+		return fmt.Errorf("failed to get storage pools capacity to check replication capacity: %v", err)
+	}
+
+	var candidates, capacities []string
+	for _, sp := range spc.StoragePoolsCapacity {
+		if sp == nil || sp.ReplicationEnabled == nil || !*sp.ReplicationEnabled {
+			continue
+		}
+		if pool != "" && sp.PoolName != pool {
+			continue
+		}
+		if pool == "" && storageType != "" && sp.StorageType != storageType {
+			continue
+		}
+		candidates = append(candidates, sp.PoolName)
+		if sp.MaxAllocationSize != nil {
+			if float64(*sp.MaxAllocationSize) >= size {
+				return nil
+			}
+			capacities = append(capacities, fmt.Sprintf("%s (max allocation %d GB, available %d GB)", sp.PoolName, *sp.MaxAllocationSize, sp.AvailableCapacity))
+		}
+	}
+
+	switch {
+	case len(candidates) == 0 && pool != "":
+		return fmt.Errorf("storage pool %s is not replication enabled", pool)
+	case len(candidates) == 0 && storageType != "":
+		return fmt.Errorf("no replication enabled storage pool found for storage type %s", storageType)
+	case len(candidates) == 0:
+		return fmt.Errorf("no replication enabled storage pool found")
+	case len(capacities) == 0:
+		// The API did not report allocation sizes; let the create request decide.
+		return nil
+	default:
+		return fmt.Errorf("no replication enabled storage pool has capacity for a %v GB volume: %s", size, strings.Join(capacities, ", "))
+	}
+}
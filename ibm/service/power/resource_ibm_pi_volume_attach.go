@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	st "github.com/IBM-Cloud/power-go-client/clients/instance"
@@ -56,6 +57,12 @@ func ResourceIBMPIVolumeAttach() *schema.Resource {
 			},
 
 			// Computed Attribute
+			Attr_PVMInstanceIDs: {
+				Computed:    true,
+				Description: "The IDs of all pvm instances the volume is attached to; more than one for shareable volumes.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Type:        schema.TypeList,
+			},
 			helpers.PIVolumeAttachStatus: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -67,7 +74,7 @@ func ResourceIBMPIVolumeAttach() *schema.Resource {
 func resourceIBMPIVolumeAttachCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	volumeID := d.Get(helpers.PIVolumeId).(string)
@@ -77,33 +84,37 @@ func resourceIBMPIVolumeAttachCreate(ctx context.Context, d *schema.ResourceData
 	volClient := st.NewIBMPIVolumeClient(ctx, sess, cloudInstanceID)
 	volinfo, err := volClient.Get(volumeID)
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
-	if volinfo.State == "available" || *volinfo.Shareable {
-		log.Printf(" In the current state the volume can be attached to the instance ")
+	shareable := volinfo.Shareable != nil && *volinfo.Shareable
+	if flex.StringContains(volinfo.PvmInstanceIDs, pvmInstanceID) {
+		return diag.Errorf("volume %s is already attached to instance %s; import the attachment instead", volumeID, pvmInstanceID)
 	}
-
-	if volinfo.State == "in-use" && *volinfo.Shareable {
-
-		log.Printf("Volume State /Status is  permitted and hence attaching the volume to the instance")
+	if volinfo.State == helpers.PIVolumeAllowableAttachStatus && !shareable {
+		return diag.Errorf("volume %s is not shareable and already attached to %s. The volume must be in the *available* state", volumeID, strings.Join(volinfo.PvmInstanceIDs, ", "))
 	}
 
-	if volinfo.State == helpers.PIVolumeAllowableAttachStatus && !*volinfo.Shareable {
-		return diag.Errorf("the volume cannot be attached in the current state. The volume must be in the *available* state. No other states are permissible")
+	// A shareable volume that is being attached to another instance is in a transitional state;
+	// wait for that attachment to settle before attaching it here.
+	if volinfo.State != State_Available && volinfo.State != helpers.PIVolumeAllowableAttachStatus {
+		log.Printf("[DEBUG] volume %s is %s, waiting for it before attaching to instance %s", volumeID, volinfo.State, pvmInstanceID)
+		if _, err := isWaitForIBMPIVolumeAvailable(ctx, volClient, volumeID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return piDiagFromErr(err)
+		}
 	}
 
 	err = volClient.Attach(pvmInstanceID, volumeID)
 	if err != nil {
 		log.Printf("[DEBUG]  err %s", err)
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s/%s", cloudInstanceID, pvmInstanceID, *volinfo.VolumeID))
 
 	_, err = isWaitForIBMPIVolumeAttachAvailable(ctx, volClient, *volinfo.VolumeID, cloudInstanceID, pvmInstanceID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	return resourceIBMPIVolumeAttachRead(ctx, d, meta)
@@ -112,12 +123,12 @@ func resourceIBMPIVolumeAttachCreate(ctx context.Context, d *schema.ResourceData
 func resourceIBMPIVolumeAttachRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
-	ids, err := flex.IdParts(d.Id())
+	ids, err := splitIDParts(d.Id(), "cloud_instance_id", "instance_id", "volume_id")
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	cloudInstanceID, pvmInstanceID, volumeID := ids[0], ids[1], ids[2]
 
@@ -125,9 +136,18 @@ func resourceIBMPIVolumeAttachRead(ctx context.Context, d *schema.ResourceData,
 
 	vol, err := client.CheckVolumeAttach(pvmInstanceID, volumeID)
 	if err != nil {
-		return diag.FromErr(err)
+		if _, ok := errors.Unwrap(err).(*p_cloud_volumes.PcloudPvminstancesVolumesGetNotFound); ok {
+			log.Printf("[WARN] volume %s is no longer attached to instance %s, removing attachment from state", volumeID, pvmInstanceID)
+			d.SetId("")
+			return nil
+		}
+		return piDiagFromErr(err)
 	}
 
+	d.Set(helpers.PICloudInstanceId, cloudInstanceID)
+	d.Set(helpers.PIInstanceId, pvmInstanceID)
+	d.Set(helpers.PIVolumeId, volumeID)
+	d.Set(Attr_PVMInstanceIDs, vol.PvmInstanceIDs)
 	d.Set(helpers.PIVolumeAttachStatus, vol.State)
 	return nil
 }
@@ -136,11 +156,11 @@ func resourceIBMPIVolumeAttachDelete(ctx context.Context, d *schema.ResourceData
 
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
-	ids, err := flex.IdParts(d.Id())
+	ids, err := splitIDParts(d.Id(), "cloud_instance_id", "instance_id", "volume_id")
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	cloudInstanceID, pvmInstanceID, volumeID := ids[0], ids[1], ids[2]
 	client := st.NewIBMPIVolumeClient(ctx, sess, cloudInstanceID)
@@ -157,12 +177,12 @@ func resourceIBMPIVolumeAttachDelete(ctx context.Context, d *schema.ResourceData
 			return nil
 		}
 		log.Printf("[DEBUG] volume detach failed %v", err)
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	_, err = isWaitForIBMPIVolumeDetach(ctx, client, volumeID, cloudInstanceID, pvmInstanceID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	// wait for power volume states to be back as available. if it's attached it will be in-use
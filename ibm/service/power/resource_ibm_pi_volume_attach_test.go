@@ -51,6 +51,7 @@ func TestAccIBMPIShareableVolumeAttachbasic(t *testing.T) {
 					testAccCheckIBMPIVolumeAttachExists("ibm_pi_volume_attach.power_attach_volume"),
 					resource.TestCheckResourceAttrSet("ibm_pi_volume_attach.power_attach_volume", "id"),
 					resource.TestCheckResourceAttrSet("ibm_pi_volume_attach.power_attach_volume", "status"),
+					resource.TestCheckResourceAttr("ibm_pi_volume_attach.power_attach_volume", "pvm_instance_ids.#", "2"),
 				),
 			},
 		},
@@ -5,12 +5,14 @@ package power
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"time"
 
 	st "github.com/IBM-Cloud/power-go-client/clients/instance"
 	"github.com/IBM-Cloud/power-go-client/helpers"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_volumes"
 	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -42,7 +44,7 @@ func ResourceIBMPIVolumeClone() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 				ForceNew:    true,
-				Description: "The base name of the newly cloned volume(s).",
+				Description: "The base name of the newly cloned volume(s). The names of the cloned volumes are prefixed with `clone-` and suffixed with a random number.",
 			},
 			PIVolumeIds: {
 				Type:        schema.TypeSet,
@@ -94,7 +96,7 @@ func ResourceIBMPIVolumeClone() *schema.Resource {
 func resourceIBMPIVolumeCloneCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
@@ -117,14 +119,14 @@ func resourceIBMPIVolumeCloneCreate(ctx context.Context, d *schema.ResourceData,
 	client := st.NewIBMPICloneVolumeClient(ctx, sess, cloudInstanceID)
 	volClone, err := client.Create(body)
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, *volClone.CloneTaskID))
 
 	_, err = isWaitForIBMPIVolumeCloneCompletion(ctx, client, *volClone.CloneTaskID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	return resourceIBMPIVolumeCloneRead(ctx, d, meta)
@@ -134,18 +136,23 @@ func resourceIBMPIVolumeCloneRead(ctx context.Context, d *schema.ResourceData, m
 
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID, vcTaskID, err := splitID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	client := st.NewIBMPICloneVolumeClient(ctx, sess, cloudInstanceID)
 	volCloneTask, err := client.Get(vcTaskID)
 	if err != nil {
-		return diag.FromErr(err)
+		// The cloned volumes outlive the task, which is only kept for a limited time
+		if _, ok := errors.Unwrap(err).(*p_cloud_volumes.PcloudV2VolumesClonetasksGetNotFound); ok {
+			log.Printf("[DEBUG] volume clone task %s no longer exists", vcTaskID)
+			return nil
+		}
+		return piDiagFromErr(err)
 	}
 
 	d.Set("task_id", vcTaskID)
@@ -204,8 +211,14 @@ func isIBMPIVolumeCloneRefreshFunc(client *st.IBMPICloneVolumeClient, id string)
 			return nil, "", err
 		}
 
-		if *volClone.Status == VolumeCloneCompleted {
+		if volClone.Status == nil {
+			return volClone, VolumeCloneRunning, nil
+		}
+		switch *volClone.Status {
+		case VolumeCloneCompleted:
 			return volClone, VolumeCloneCompleted, nil
+		case VolumeCloneFailed:
+			return volClone, VolumeCloneFailed, fmt.Errorf("volume clone task %s failed: %s", id, volClone.FailedReason)
 		}
 
 		return volClone, VolumeCloneRunning, nil
@@ -0,0 +1,152 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceIBMPIVolumeDeleteProtection tracks the volumes of a workspace whose names match a
+// pattern and refuses to be destroyed while any of them exist, unless pi_allow_delete is set.
+// The protection only lives in state and the volumes themselves are not changed, so it holds
+// back the destroy of only the volume resources that depend on it.
+func ResourceIBMPIVolumeDeleteProtection() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPIVolumeDeleteProtectionCreate,
+		ReadContext:   resourceIBMPIVolumeDeleteProtectionRead,
+		UpdateContext: resourceIBMPIVolumeDeleteProtectionUpdate,
+		DeleteContext: resourceIBMPIVolumeDeleteProtectionDelete,
+
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_AllowDelete: {
+				Default:     false,
+				Description: "Indicates if the protection can be destroyed while volumes matching the pattern exist.",
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
+			Arg_CloudInstanceID: {
+				Description:  "The GUID of the service instance associated with an account.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_VolumeNameRegex: {
+				Description:  "Regular expression matching the names of the volumes to protect.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+
+			// Attributes
+			Attr_VolumeIDs: {
+				Computed:    true,
+				Description: "The IDs of the volumes matching the pattern.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Type:        schema.TypeList,
+			},
+		},
+	}
+}
+
+func resourceIBMPIVolumeDeleteProtectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	protectionID, err := uuid.GenerateUUID()
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, protectionID))
+
+	return resourceIBMPIVolumeDeleteProtectionRead(ctx, d, meta)
+}
+
+func resourceIBMPIVolumeDeleteProtectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	volumeIDs, _, err := protectedIBMPIVolumes(ctx, d, meta)
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+	d.Set(Attr_VolumeIDs, volumeIDs)
+
+	return nil
+}
+
+func resourceIBMPIVolumeDeleteProtectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The arguments only live in state
+	return resourceIBMPIVolumeDeleteProtectionRead(ctx, d, meta)
+}
+
+func resourceIBMPIVolumeDeleteProtectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.Get(Arg_AllowDelete).(bool) {
+		_, names, err := protectedIBMPIVolumes(ctx, d, meta)
+		if err != nil {
+			return piDiagFromErr(err)
+		}
+		if len(names) > 0 {
+			return diag.Errorf("volumes %s are protected from deletion by %s; set %s to true and apply before destroying them", strings.Join(names, ", "), Arg_VolumeNameRegex, Arg_AllowDelete)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// protectedIBMPIVolumes returns the sorted IDs and names of the volumes of the workspace whose
+// names match pi_volume_name_regex.
+func protectedIBMPIVolumes(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]string, []string, error) {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cloudInstanceID, _, err := splitID(d.Id())
+	if err != nil {
+		return nil, nil, err
+	}
+	pattern, err := regexp.Compile(d.Get(Arg_VolumeNameRegex).(string))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	volumes, err := instance.NewIBMPIVolumeClient(ctx, sess, cloudInstanceID).GetAll()
+	if err != nil {
+		log.Printf("[ERROR] get all volumes failed %v", err)
+		return nil, nil, err
+	}
+
+	var matched []*models.VolumeReference
+	for _, volume := range volumes.Volumes {
+		if volume != nil && volume.VolumeID != nil && volume.Name != nil && pattern.MatchString(*volume.Name) {
+			matched = append(matched, volume)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		if *matched[i].Name != *matched[j].Name {
+			return *matched[i].Name < *matched[j].Name
+		}
+		return *matched[i].VolumeID < *matched[j].VolumeID
+	})
+
+	volumeIDs := make([]string, 0, len(matched))
+	names := make([]string, 0, len(matched))
+	for _, volume := range matched {
+		volumeIDs = append(volumeIDs, *volume.VolumeID)
+		names = append(names, *volume.Name)
+	}
+
+	return volumeIDs, names, nil
+}
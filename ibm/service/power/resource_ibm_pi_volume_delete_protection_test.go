@@ -0,0 +1,60 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMPIVolumeDeleteProtectionSweep(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-volume-protected-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIVolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIVolumeDeleteProtectionSweepConfig(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIVolumeExists("ibm_pi_volume.power_volume"),
+					resource.TestCheckResourceAttr("ibm_pi_volume_delete_protection.protection", "volume_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("ibm_pi_volume_delete_protection.protection", "volume_ids.0", "ibm_pi_volume.power_volume", "volume_id"),
+				),
+			},
+			{
+				Config:      testAccCheckIBMPIVolumeDeleteProtectionSweepConfig(name, false),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("protected from deletion"),
+			},
+			{
+				Config: testAccCheckIBMPIVolumeDeleteProtectionSweepConfig(name, true),
+				Check:  resource.TestCheckResourceAttr("ibm_pi_volume_delete_protection.protection", "pi_allow_delete", "true"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIVolumeDeleteProtectionSweepConfig(name string, allowDelete bool) string {
+	return fmt.Sprintf(`
+		resource "ibm_pi_volume" "power_volume" {
+			pi_cloud_instance_id	= "%[2]s"
+			pi_volume_name		= "%[1]s"
+			pi_volume_size		= 20
+			pi_volume_type		= "tier1"
+		}
+
+		resource "ibm_pi_volume_delete_protection" "protection" {
+			pi_allow_delete		= %[3]t
+			pi_cloud_instance_id	= "%[2]s"
+			pi_volume_name_regex	= "^%[1]s$"
+			depends_on		= [ibm_pi_volume.power_volume]
+		}`, name, acc.Pi_cloud_instance_id, allowDelete)
+}
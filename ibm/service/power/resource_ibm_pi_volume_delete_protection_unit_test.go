@@ -0,0 +1,60 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/power/internal/powertest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceIBMPIVolumeDeleteProtectionDelete(t *testing.T) {
+	server := powertest.NewServer(t)
+	for id, name := range map[string]string{"1": "db-data-2", "2": "db-data-1", "3": "app-data"} {
+		volumeID, volumeName := id, name
+		server.AddVolume(&models.Volume{Name: &volumeName, VolumeID: &volumeID})
+	}
+
+	tests := []struct {
+		name        string
+		allowDelete bool
+		pattern     string
+		wantErr     string
+	}{
+		{name: "protected", pattern: "^db-", wantErr: "volumes db-data-1, db-data-2 are protected"},
+		{name: "allowed", pattern: "^db-", allowDelete: true},
+		{name: "no match", pattern: "^web-"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, ResourceIBMPIVolumeDeleteProtection().Schema, map[string]interface{}{
+				Arg_AllowDelete:     tc.allowDelete,
+				Arg_CloudInstanceID: powertest.CloudInstanceID,
+				Arg_VolumeNameRegex: tc.pattern,
+			})
+			d.SetId(powertest.CloudInstanceID + "/protection")
+
+			diags := resourceIBMPIVolumeDeleteProtectionDelete(context.Background(), d, server.Meta(t))
+			if tc.wantErr != "" {
+				if !diags.HasError() || !strings.Contains(diags[0].Summary, tc.wantErr) {
+					t.Errorf("diagnostics = %v, want an error containing %q", diags, tc.wantErr)
+				}
+				if d.Id() == "" {
+					t.Error("the protection was removed from state")
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if d.Id() != "" {
+				t.Error("the protection was not removed from state")
+			}
+		})
+	}
+}
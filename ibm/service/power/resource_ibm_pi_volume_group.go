@@ -81,6 +81,17 @@ func ResourceIBMPIVolumeGroup() *schema.Resource {
 				Computed:    true,
 				Description: "Consistency Group Name if volume is a part of volume group",
 			},
+			Attr_CyclePeriodSeconds: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The minimum period in seconds between multiple cycles of the replication, when replication is enabled",
+			},
+			Attr_CyclingMode: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of cycling mode used by the replication, when replication is enabled",
+			},
+			Attr_RemoteCopyRelationships: remoteCopyRelationshipsSchema(),
 		},
 	}
 }
@@ -88,7 +99,7 @@ func ResourceIBMPIVolumeGroup() *schema.Resource {
 func resourceIBMPIVolumeGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	vgName := d.Get(PIVolumeGroupName).(string)
@@ -107,14 +118,14 @@ func resourceIBMPIVolumeGroupCreate(ctx context.Context, d *schema.ResourceData,
 	client := st.NewIBMPIVolumeGroupClient(ctx, sess, cloudInstanceID)
 	vg, err := client.CreateVolumeGroup(body)
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, *vg.ID))
 
 	_, err = isWaitForIBMPIVolumeGroupAvailable(ctx, client, *vg.ID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	return resourceIBMPIVolumeGroupRead(ctx, d, meta)
@@ -123,19 +134,19 @@ func resourceIBMPIVolumeGroupCreate(ctx context.Context, d *schema.ResourceData,
 func resourceIBMPIVolumeGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID, vgID, err := splitID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	client := st.NewIBMPIVolumeGroupClient(ctx, sess, cloudInstanceID)
 
 	vg, err := client.GetDetails(vgID)
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	d.Set("volume_group_id", vg.ID)
@@ -146,6 +157,25 @@ func resourceIBMPIVolumeGroupRead(ctx context.Context, d *schema.ResourceData, m
 	d.Set(PIVolumeIds, vg.VolumeIDs)
 	d.Set("status_description_errors", flattenVolumeGroupStatusDescription(vg.StatusDescription.Errors))
 
+	relationships, err := getVolumeGroupRemoteCopyRelationships(client, vgID, vg.ReplicationStatus)
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+	d.Set(Attr_RemoteCopyRelationships, relationships)
+
+	// The replication schedule is only reported by the storage controller of replicated groups
+	if vg.ReplicationStatus == State_Enabled {
+		details, err := client.GetVolumeGroupLiveDetails(vgID)
+		if err != nil {
+			return piDiagFromErr(err)
+		}
+		d.Set(Attr_CyclePeriodSeconds, details.CyclePeriodSeconds)
+		d.Set(Attr_CyclingMode, details.CyclingMode)
+	} else {
+		d.Set(Attr_CyclePeriodSeconds, 0)
+		d.Set(Attr_CyclingMode, "")
+	}
+
 	return nil
 }
 
@@ -153,12 +183,12 @@ func resourceIBMPIVolumeGroupUpdate(ctx context.Context, d *schema.ResourceData,
 
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID, vgID, err := splitID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	client := st.NewIBMPIVolumeGroupClient(ctx, sess, cloudInstanceID)
@@ -172,11 +202,11 @@ func resourceIBMPIVolumeGroupUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 		err := client.UpdateVolumeGroup(vgID, body)
 		if err != nil {
-			return diag.FromErr(err)
+			return piDiagFromErr(err)
 		}
 		_, err = isWaitForIBMPIVolumeGroupAvailable(ctx, client, vgID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return piDiagFromErr(err)
 		}
 	}
 
@@ -185,12 +215,12 @@ func resourceIBMPIVolumeGroupUpdate(ctx context.Context, d *schema.ResourceData,
 func resourceIBMPIVolumeGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID, vgID, err := splitID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	client := st.NewIBMPIVolumeGroupClient(ctx, sess, cloudInstanceID)
@@ -202,21 +232,21 @@ func resourceIBMPIVolumeGroupDelete(ctx context.Context, d *schema.ResourceData,
 		}
 		err = client.UpdateVolumeGroup(vgID, body)
 		if err != nil {
-			return diag.FromErr(err)
+			return piDiagFromErr(err)
 		}
 		_, err = isWaitForIBMPIVolumeGroupAvailable(ctx, client, vgID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
-			return diag.FromErr(err)
+			return piDiagFromErr(err)
 		}
 	}
 
 	err = client.DeleteVolumeGroup(vgID)
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	_, err = isWaitForIBMPIVolumeGroupDeleted(ctx, client, vgID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	d.SetId("")
@@ -5,15 +5,20 @@ package power
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
+	"strings"
 	"time"
 
 	st "github.com/IBM-Cloud/power-go-client/clients/instance"
 	"github.com/IBM-Cloud/power-go-client/helpers"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_volume_groups"
 	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/softlayer/softlayer-go/sl"
 )
@@ -100,6 +105,41 @@ func ResourceIBMPIVolumeGroupAction() *schema.Resource {
 			},
 
 			// Computed Attributes
+			Attr_ActionResult: {
+				Computed:    true,
+				Description: "The result of the action, succeeded or failed.",
+				Type:        schema.TypeString,
+			},
+			Attr_LastError: {
+				Computed:    true,
+				Description: "The error the action failed with; empty when the action succeeded.",
+				Type:        schema.TypeString,
+			},
+			Attr_StatusDescriptionErrors: {
+				Computed:    true,
+				Description: "The status details of the volume group.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_Key: {
+							Computed:    true,
+							Description: "The volume group error key.",
+							Type:        schema.TypeString,
+						},
+						Attr_Message: {
+							Computed:    true,
+							Description: "The failure message providing more details about the error key.",
+							Type:        schema.TypeString,
+						},
+						Attr_VolumeIDs: {
+							Computed:    true,
+							Description: "List of volume IDs, which failed to be added/removed to/from the volume group, with the given error.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Type:        schema.TypeList,
+						},
+					},
+				},
+				Type: schema.TypeSet,
+			},
 			"volume_group_name": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -122,13 +162,13 @@ func ResourceIBMPIVolumeGroupAction() *schema.Resource {
 func resourceIBMPIVolumeGroupActionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	vgID := d.Get(PIVolumeGroupID).(string)
 	vgAction, err := expandVolumeGroupAction(d.Get(PIVolumeGroupAction).([]interface{}))
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(helpers.PICloudInstanceId).(string)
@@ -137,40 +177,59 @@ func resourceIBMPIVolumeGroupActionCreate(ctx context.Context, d *schema.Resourc
 	client := st.NewIBMPIVolumeGroupClient(ctx, sess, cloudInstanceID)
 	_, err = client.VolumeGroupAction(vgID, body)
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, vgID))
 
-	_, err = isWaitForIBMPIVolumeGroupAvailable(ctx, client, vgID, d.Timeout(schema.TimeoutCreate))
+	// Record the outcome and read the group even when the action failed, so that the status
+	// and status description errors explaining the failure end up in state.
+	// A reset is what moves a group out of error, so only other actions fail fast on error
+	_, err = isWaitForIBMPIVolumeGroupActionDone(ctx, client, vgID, body.Reset == nil, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		d.Set(Attr_ActionResult, State_Failed)
+		d.Set(Attr_LastError, err.Error())
+	} else {
+		d.Set(Attr_ActionResult, "succeeded")
+		d.Set(Attr_LastError, "")
 	}
 
-	return resourceIBMPIVolumeGroupActionRead(ctx, d, meta)
+	diags := resourceIBMPIVolumeGroupActionRead(ctx, d, meta)
+	if err != nil {
+		return append(piDiagFromErr(err), diags...)
+	}
+	return diags
 }
 
 func resourceIBMPIVolumeGroupActionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID, vgID, err := splitID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	client := st.NewIBMPIVolumeGroupClient(ctx, sess, cloudInstanceID)
 
-	vg, err := client.GetDetails(vgID)
+	vg, err := client.Get(vgID)
 	if err != nil {
-		return diag.FromErr(err)
+		if _, ok := errors.Unwrap(err).(*p_cloud_volume_groups.PcloudVolumegroupsGetNotFound); ok {
+			log.Printf("[WARN] volume group %s not found, removing action from state", vgID)
+			d.SetId("")
+			return nil
+		}
+		return piDiagFromErr(err)
 	}
 
 	d.Set("volume_group_name", vg.Name)
 	d.Set("volume_group_status", vg.Status)
 	d.Set("replication_status", vg.ReplicationStatus)
+	if vg.StatusDescription != nil {
+		d.Set(Attr_StatusDescriptionErrors, flattenVolumeGroupStatusDescription(vg.StatusDescription.Errors))
+	}
 
 	return nil
 }
@@ -181,64 +240,97 @@ func resourceIBMPIVolumeGroupActionDelete(ctx context.Context, d *schema.Resourc
 	return nil
 }
 
+// isWaitForIBMPIVolumeGroupActionDone waits for the volume group to be available after an action
+// and, with failOnError, fails as soon as the group is in error, with the status description
+// errors as the reason.
+func isWaitForIBMPIVolumeGroupActionDone(ctx context.Context, client *st.IBMPIVolumeGroupClient, id string, failOnError bool, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for action on Volume Group (%s) to complete.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{State_Retry, helpers.PIVolumeProvisioning},
+		Target:     []string{helpers.PIVolumeProvisioningDone},
+		Refresh:    isIBMPIVolumeGroupActionRefreshFunc(client, id, failOnError),
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+		Timeout:    timeout,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isIBMPIVolumeGroupActionRefreshFunc(client *st.IBMPIVolumeGroupClient, id string, failOnError bool) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		vg, err := client.Get(id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := strings.ToLower(vg.Status)
+		switch {
+		case status == State_Available:
+			return vg, helpers.PIVolumeProvisioningDone, nil
+		case status == State_Error && failOnError:
+			var messages []string
+			if vg.StatusDescription != nil {
+				for _, e := range vg.StatusDescription.Errors {
+					if e != nil {
+						messages = append(messages, fmt.Sprintf("%s: %s", e.Key, e.Message))
+					}
+				}
+			}
+			if len(messages) == 0 {
+				return vg, vg.Status, fmt.Errorf("volume group %s is in error state", id)
+			}
+			return vg, vg.Status, fmt.Errorf("volume group %s is in error state: %s", id, strings.Join(messages, "; "))
+		}
+
+		return vg, helpers.PIVolumeProvisioning, nil
+	}
+}
+
 // expandVolumeGroupAction retrieve volume group action resource
 func expandVolumeGroupAction(data []interface{}) (*models.VolumeGroupAction, error) {
-	if len(data) == 0 {
+	// An empty block is decoded as a nil element rather than an empty map, so every
+	// level is asserted with a check instead of assuming the shape of the config.
+	action, ok := firstBlock(data)
+	if !ok {
 		return nil, fmt.Errorf("[ERROR] no pi_volume_group_action received")
 	}
 
 	vgAction := models.VolumeGroupAction{}
-	action := data[0].(map[string]interface{})
-
-	if v, ok := action["start"]; ok && len(v.([]interface{})) != 0 {
-		vgAction.Start = expandVolumeGroupStartAction(action["start"].([]interface{}))
+	if start, ok := firstBlock(action["start"]); ok {
+		source, _ := start["source"].(string)
+		vgAction.Start = &models.VolumeGroupActionStart{
+			Source: sl.String(source),
+		}
 		return &vgAction, nil
 	}
 
-	if v, ok := action["stop"]; ok && len(v.([]interface{})) != 0 {
-		vgAction.Stop = expandVolumeGroupStopAction(action["stop"].([]interface{}))
+	if stop, ok := firstBlock(action["stop"]); ok {
+		access, _ := stop["access"].(bool)
+		vgAction.Stop = &models.VolumeGroupActionStop{
+			Access: sl.Bool(access),
+		}
 		return &vgAction, nil
 	}
 
-	if v, ok := action["reset"]; ok && len(v.([]interface{})) != 0 {
-		vgAction.Reset = expandVolumeGroupResetAction(action["reset"].([]interface{}))
+	if reset, ok := firstBlock(action["reset"]); ok {
+		status, _ := reset["status"].(string)
+		vgAction.Reset = &models.VolumeGroupActionReset{
+			Status: sl.String(status),
+		}
 		return &vgAction, nil
 	}
 	return nil, fmt.Errorf("[ERROR] no pi_volume_group_action received")
 }
 
-func expandVolumeGroupStartAction(start []interface{}) *models.VolumeGroupActionStart {
-	if len(start) == 0 {
-		return nil
-	}
-
-	s := start[0].(map[string]interface{})
-
-	return &models.VolumeGroupActionStart{
-		Source: sl.String(s["source"].(string)),
-	}
-}
-
-func expandVolumeGroupStopAction(stop []interface{}) *models.VolumeGroupActionStop {
-	if len(stop) == 0 {
-		return nil
-	}
-
-	s := stop[0].(map[string]interface{})
-
-	return &models.VolumeGroupActionStop{
-		Access: sl.Bool(s["access"].(bool)),
-	}
-}
-
-func expandVolumeGroupResetAction(reset []interface{}) *models.VolumeGroupActionReset {
-	if len(reset) == 0 {
-		return nil
-	}
-
-	s := reset[0].(map[string]interface{})
-
-	return &models.VolumeGroupActionReset{
-		Status: sl.String(s["status"].(string)),
+// firstBlock returns the attributes of the first element of a TypeList block, and false when the
+// block is absent or was left empty in the configuration.
+func firstBlock(v interface{}) (map[string]interface{}, bool) {
+	list, ok := v.([]interface{})
+	if !ok || len(list) == 0 {
+		return nil, false
 	}
+	block, ok := list[0].(map[string]interface{})
+	return block, ok
 }
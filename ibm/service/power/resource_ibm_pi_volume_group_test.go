@@ -31,6 +31,8 @@ func TestAccIBMPIVolumeGroupUpdate(t *testing.T) {
 					testAccCheckIBMPIVolumeGroupExists("ibm_pi_volume_group.power_volume_group"),
 					resource.TestCheckResourceAttr(
 						"ibm_pi_volume_group.power_volume_group", "pi_volume_group_name", name),
+					resource.TestCheckResourceAttrSet(
+						"ibm_pi_volume_group.power_volume_group", "cycle_period_seconds"),
 				),
 			},
 			{
@@ -5,7 +5,9 @@ package power
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	st "github.com/IBM-Cloud/power-go-client/clients/instance"
@@ -30,6 +32,7 @@ func ResourceIBMPIVolumeOnboarding() *schema.Resource {
 		CreateContext: resourceIBMPIVolumeOnboardingCreate,
 		ReadContext:   resourceIBMPIVolumeOnboardingRead,
 		DeleteContext: resourceIBMPIVolumeOnboardingDelete,
+		CustomizeDiff: resourceIBMPIVolumeOnboardingCustomizeDiff,
 		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -60,7 +63,7 @@ func ResourceIBMPIVolumeOnboarding() *schema.Resource {
 						},
 						piAuxiliaryVolumes: {
 							Type:     schema.TypeList,
-							Optional: true,
+							Required: true,
 							MinItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -145,14 +148,14 @@ func ResourceIBMPIVolumeOnboarding() *schema.Resource {
 func resourceIBMPIVolumeOnboardingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	cloudInstanceID := d.Get(helpers.PICloudInstanceId).(string)
 	client := st.NewIBMPIVolumeOnboardingClient(ctx, sess, cloudInstanceID)
 
 	vol, err := expandCreateVolumeOnboarding(d.Get(piOnboardingVolumes).([]interface{}))
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	body := &models.VolumeOnboardingCreate{
@@ -165,7 +168,7 @@ func resourceIBMPIVolumeOnboardingCreate(ctx context.Context, d *schema.Resource
 
 	resOnboarding, err := client.CreateVolumeOnboarding(body)
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, resOnboarding.ID))
@@ -176,19 +179,19 @@ func resourceIBMPIVolumeOnboardingCreate(ctx context.Context, d *schema.Resource
 func resourceIBMPIVolumeOnboardingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID, onboardingID, err := splitID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	client := st.NewIBMPIVolumeOnboardingClient(ctx, sess, cloudInstanceID)
 
 	onboardingData, err := client.Get(onboardingID)
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	d.Set("onboarding_id", *onboardingData.ID)
@@ -208,10 +211,91 @@ func resourceIBMPIVolumeOnboardingDelete(ctx context.Context, d *schema.Resource
 	return nil
 }
 
+// resourceIBMPIVolumeOnboardingCustomizeDiff reports invalid onboarding volumes at plan time instead
+// of letting the onboarding operation fail server-side.
+func resourceIBMPIVolumeOnboardingCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown(piOnboardingVolumes) {
+		return nil
+	}
+	return validateVolumeOnboardingVolumes(diff.Get(piOnboardingVolumes).([]interface{}), func(key string) bool {
+		return diff.NewValueKnown(fmt.Sprintf("%s.%s", piOnboardingVolumes, key))
+	})
+}
+
+// validateVolumeOnboardingVolumes checks every onboarding volume entry for a well formed source CRN,
+// at least one auxiliary volume and unique auxiliary volume names per source, and returns all the
+// problems found at once. Values for which known returns false are not validated.
+func validateVolumeOnboardingVolumes(data []interface{}, known func(key string) bool) error {
+	if len(data) == 0 {
+		return fmt.Errorf("at least one %s entry is required", piOnboardingVolumes)
+	}
+
+	var errs []error
+	auxVolumeNames := make(map[string]string)
+	for i, d := range data {
+		resource, ok := d.(map[string]interface{})
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s.%d: entry is empty", piOnboardingVolumes, i))
+			continue
+		}
+
+		crn, _ := resource[piSourceCRN].(string)
+		if known(fmt.Sprintf("%d.%s", i, piSourceCRN)) {
+			if err := validateSourceCRN(crn); err != nil {
+				errs = append(errs, fmt.Errorf("%s.%d.%s: %w", piOnboardingVolumes, i, piSourceCRN, err))
+			}
+		}
+
+		if !known(fmt.Sprintf("%d.%s", i, piAuxiliaryVolumes)) {
+			continue
+		}
+		auxVolumes, _ := resource[piAuxiliaryVolumes].([]interface{})
+		if len(auxVolumes) == 0 {
+			errs = append(errs, fmt.Errorf("%s.%d.%s: at least one auxiliary volume is required", piOnboardingVolumes, i, piAuxiliaryVolumes))
+			continue
+		}
+		for j, av := range auxVolumes {
+			auxVolume, _ := av.(map[string]interface{})
+			name, _ := auxVolume[piAuxiliaryVolumeName].(string)
+			key := fmt.Sprintf("%s.%d.%s.%d.%s", piOnboardingVolumes, i, piAuxiliaryVolumes, j, piAuxiliaryVolumeName)
+			if !known(fmt.Sprintf("%d.%s.%d.%s", i, piAuxiliaryVolumes, j, piAuxiliaryVolumeName)) {
+				continue
+			}
+			if name == "" {
+				errs = append(errs, fmt.Errorf("%s: auxiliary volume name must not be empty", key))
+				continue
+			}
+			if first, ok := auxVolumeNames[crn+"/"+name]; ok {
+				errs = append(errs, fmt.Errorf("%s: auxiliary volume %q of source %s is already listed in %s", key, name, crn, first))
+				continue
+			}
+			auxVolumeNames[crn+"/"+name] = key
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateSourceCRN checks that crn has the crn:v1:<cname>:<ctype>:<service-name>:<location>:<scope>:<service-instance>:<resource-type>:<resource>
+// format and names a service instance.
+func validateSourceCRN(crn string) error {
+	if crn == "" {
+		return fmt.Errorf("source CRN must not be empty")
+	}
+	parts := strings.Split(crn, ":")
+	if len(parts) != 10 || parts[0] != "crn" || parts[1] != "v1" {
+		return fmt.Errorf("%q is not a valid CRN, expected crn:v1:<cname>:<ctype>:<service-name>:<location>:<scope>:<service-instance>:<resource-type>:<resource>", crn)
+	}
+	if parts[4] == "" || parts[7] == "" {
+		return fmt.Errorf("%q does not identify a service instance", crn)
+	}
+	return nil
+}
+
 // expandCreateVolumeOnboarding expands create volume onboarding resource
 func expandCreateVolumeOnboarding(data []interface{}) ([]*models.AuxiliaryVolumesForOnboarding, error) {
-	if len(data) == 0 {
-		return nil, fmt.Errorf("[ERROR] no pi_onboarding_volumes received")
+	if err := validateVolumeOnboardingVolumes(data, func(string) bool { return true }); err != nil {
+		return nil, err
 	}
 
 	auxVolForOnboarding := make([]*models.AuxiliaryVolumesForOnboarding, 0)
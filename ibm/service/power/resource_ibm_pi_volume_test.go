@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -44,6 +45,11 @@ func TestAccIBMPIVolumebasic(t *testing.T) {
 						"ibm_pi_volume.power_volume", "pi_volume_size", "30"),
 				),
 			},
+			{
+				Config:      testAccCheckIBMPIVolumeConfig(name),
+				ExpectError: regexp.MustCompile("volumes can only be expanded"),
+				PlanOnly:    true,
+			},
 		},
 	})
 }
@@ -157,6 +163,46 @@ func testAccCheckIBMPIVolumePoolConfig(name string) string {
 		}`, name, acc.Pi_cloud_instance_id, acc.PiStoragePool)
 }
 
+func TestAccIBMPIVolumeDeleteProtection(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-volume-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIVolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIVolumeDeleteProtectionConfig(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIVolumeExists("ibm_pi_volume.power_volume"),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_volume.power_volume", "pi_delete_protection", "true"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPIVolumeDeleteProtectionConfig(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIVolumeExists("ibm_pi_volume.power_volume"),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_volume.power_volume", "pi_delete_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIVolumeDeleteProtectionConfig(name string, deleteProtection bool) string {
+	return fmt.Sprintf(`
+		resource "ibm_pi_volume" "power_volume" {
+			pi_cloud_instance_id		= "%[2]s"
+			pi_delete_protection		= %[3]t
+			pi_force_detach_on_delete	= true
+			pi_volume_name			= "%[1]s"
+			pi_volume_shareable		= true
+			pi_volume_size			= 20
+			pi_volume_type			= "tier1"
+		}`, name, acc.Pi_cloud_instance_id, deleteProtection)
+}
+
 // TestAccIBMPIVolumeGRS test the volume replication feature which is part of global replication service(GRS)
 func TestAccIBMPIVolumeGRS(t *testing.T) {
 	name := fmt.Sprintf("tf-pi-volume-%d", acctest.RandIntRange(10, 100))
@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/power/internal/powertest"
+)
+
+func TestIBMPIVolumeResizeRefreshFunc(t *testing.T) {
+	server := powertest.NewServer(t)
+	client := instance.NewIBMPIVolumeClient(context.Background(), server.Session(t), powertest.CloudInstanceID)
+	for id, volume := range map[string]struct {
+		size  float64
+		state string
+	}{
+		"pending":   {size: 10, state: State_Resizing},
+		"extending": {size: 20, state: "extending"},
+		"attached":  {size: 20, state: State_InUse},
+		"detached":  {size: 20, state: State_Available},
+		"failed":    {size: 10, state: State_Error},
+	} {
+		volumeID, size := id, volume.size
+		server.AddVolume(&models.Volume{Size: &size, State: volume.state, VolumeID: &volumeID})
+	}
+
+	tests := []struct {
+		id        string
+		wantState string
+		wantErr   string
+	}{
+		{id: "pending", wantState: State_Resizing},
+		{id: "extending", wantState: State_Resizing},
+		{id: "attached", wantState: State_Available},
+		{id: "detached", wantState: State_Available},
+		{id: "failed", wantState: State_Error, wantErr: "resize of volume failed to 20 GB failed"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.id, func(t *testing.T) {
+			_, state, err := isIBMPIVolumeResizeRefreshFunc(client, tc.id, 20)()
+			if state != tc.wantState {
+				t.Errorf("state = %q, want %q", state, tc.wantState)
+			}
+			if tc.wantErr == "" && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if tc.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tc.wantErr)) {
+				t.Errorf("error = %v, want it to contain %q", err, tc.wantErr)
+			}
+		})
+	}
+}
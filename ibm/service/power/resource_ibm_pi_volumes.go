@@ -0,0 +1,456 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// volumeNameSchemeIndex is replaced by the 1-based position of the volume in pi_volume_name_scheme.
+const volumeNameSchemeIndex = "{index}"
+
+func ResourceIBMPIVolumes() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPIVolumesCreate,
+		ReadContext:   resourceIBMPIVolumesRead,
+		UpdateContext: resourceIBMPIVolumesUpdate,
+		DeleteContext: resourceIBMPIVolumesDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_AffinityInstance: {
+				ConflictsWith: []string{Arg_AffinityVolume},
+				Description:   "PVM Instance (ID or Name) to base volume affinity policy against; required if requesting 'affinity' and 'pi_affinity_volume' is not provided.",
+				ForceNew:      true,
+				Optional:      true,
+				Type:          schema.TypeString,
+			},
+			Arg_AffinityPolicy: {
+				Description:  "Affinity policy for the data volumes being created; ignored if 'pi_volume_pool' provided; for policy 'affinity' requires one of 'pi_affinity_instance' or 'pi_affinity_volume' to be specified; for policy 'anti-affinity' requires one of 'pi_anti_affinity_instances' or 'pi_anti_affinity_volumes' to be specified; Allowable values: 'affinity', 'anti-affinity'.",
+				ForceNew:     true,
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"affinity", "anti-affinity"}),
+			},
+			Arg_AffinityVolume: {
+				ConflictsWith: []string{Arg_AffinityInstance},
+				Description:   "Volume (ID or Name) to base volume affinity policy against; required if requesting 'affinity' and 'pi_affinity_instance' is not provided.",
+				ForceNew:      true,
+				Optional:      true,
+				Type:          schema.TypeString,
+			},
+			Arg_AntiAffinityInstances: {
+				ConflictsWith: []string{Arg_AntiAffinityVolumes},
+				Description:   "List of pvmInstances to base volume anti-affinity policy against; required if requesting 'anti-affinity' and 'pi_anti_affinity_volumes' is not provided.",
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ForceNew:      true,
+				Optional:      true,
+				Type:          schema.TypeList,
+			},
+			Arg_AntiAffinityVolumes: {
+				ConflictsWith: []string{Arg_AntiAffinityInstances},
+				Description:   "List of volumes to base volume anti-affinity policy against; required if requesting 'anti-affinity' and 'pi_anti_affinity_instances' is not provided.",
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ForceNew:      true,
+				Optional:      true,
+				Type:          schema.TypeList,
+			},
+			Arg_CloudInstanceID: {
+				Description:  "The GUID of the service instance associated with an account.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_ReplicationEnabled: {
+				Computed:    true,
+				Description: "Indicates if the volumes should be replication enabled or not.",
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
+			Arg_VolumeCount: {
+				Description:  "The number of volumes to create.",
+				Required:     true,
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			Arg_VolumeName: {
+				Description:  "The base name of the volumes; the API adds a suffix to make each name unique.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_VolumeNameScheme: {
+				Description:  "The name pattern the volumes are renamed to after they are created; {index} is replaced by the 1-based position of the volume, for example data-{index}.",
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(regexp.QuoteMeta(volumeNameSchemeIndex)), "must contain "+volumeNameSchemeIndex),
+			},
+			Arg_VolumePool: {
+				Computed:    true,
+				Description: "Volume pool where the volumes will be created; if provided then 'pi_affinity_policy' values will be ignored.",
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			Arg_VolumeShareable: {
+				Default:     false,
+				Description: "If set to true, the volumes can be shared across Power Systems Virtual Server instances. If set to false, you can attach each only to one instance.",
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
+			Arg_VolumeSize: {
+				Description:  "The size of each volume in GB.",
+				Required:     true,
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			Arg_VolumeType: {
+				Computed:     true,
+				Description:  "Type of disk, if diskType is not provided the disk type will default to 'tier3'",
+				ForceNew:     true,
+				Optional:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"tier0", "tier1", "tier3", "tier5k"}),
+			},
+
+			// Attributes
+			Attr_VolumeIDs: {
+				Computed:    true,
+				Description: "The IDs of the volumes, in creation order.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Type:        schema.TypeList,
+			},
+			Attr_Volumes: {
+				Computed:    true,
+				Description: "The volumes, in creation order.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_Name: {
+							Computed:    true,
+							Description: "The name of the volume.",
+							Type:        schema.TypeString,
+						},
+						Attr_Status: {
+							Computed:    true,
+							Description: "The status of the volume.",
+							Type:        schema.TypeString,
+						},
+						Attr_VolumeID: {
+							Computed:    true,
+							Description: "The unique identifier of the volume.",
+							Type:        schema.TypeString,
+						},
+						Attr_WWN: {
+							Computed:    true,
+							Description: "The world wide name of the volume.",
+							Type:        schema.TypeString,
+						},
+					},
+				},
+				Type: schema.TypeList,
+			},
+		},
+	}
+}
+
+func resourceIBMPIVolumesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	count := d.Get(Arg_VolumeCount).(int)
+
+	if d.Get(Arg_ReplicationEnabled).(bool) {
+		capacityClient := instance.NewIBMPIStorageCapacityClient(ctx, sess, cloudInstanceID)
+		if err := checkReplicationPoolCapacity(capacityClient, d.Get(Arg_VolumePool).(string), d.Get(Arg_VolumeType).(string), float64(d.Get(Arg_VolumeSize).(int))); err != nil {
+			return piDiagFromErr(err)
+		}
+	}
+
+	client := instance.NewIBMPIVolumeClient(ctx, sess, cloudInstanceID)
+	volumeIDs, err := createPIVolumes(ctx, d, client, count)
+	// Keep whatever was created in state so that it is not orphaned
+	if len(volumeIDs) > 0 {
+		genID, _ := uuid.GenerateUUID()
+		d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, genID))
+		d.Set(Attr_VolumeIDs, volumeIDs)
+	}
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+
+	if err := renamePIVolumes(ctx, d, client, volumeIDs, 0, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return piDiagFromErr(err)
+	}
+
+	return resourceIBMPIVolumesRead(ctx, d, meta)
+}
+
+func resourceIBMPIVolumesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+
+	cloudInstanceID, _, err := splitID(d.Id())
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+
+	client := instance.NewIBMPIVolumeClient(ctx, sess, cloudInstanceID)
+	volumeIDs := flex.ExpandStringList(d.Get(Attr_VolumeIDs).([]interface{}))
+	volumes := make([]map[string]interface{}, 0, len(volumeIDs))
+	var first *models.Volume
+	for _, volumeID := range volumeIDs {
+		vol, err := client.Get(volumeID)
+		if err != nil {
+			return piDiagFromErr(err)
+		}
+		if first == nil {
+			first = vol
+		}
+		volumes = append(volumes, map[string]interface{}{
+			Attr_Name:     vol.Name,
+			Attr_Status:   vol.State,
+			Attr_VolumeID: volumeID,
+			Attr_WWN:      vol.Wwn,
+		})
+	}
+
+	d.Set(Arg_CloudInstanceID, cloudInstanceID)
+	d.Set(Arg_VolumeCount, len(volumeIDs))
+	d.Set(Attr_Volumes, volumes)
+	// The volumes are created with the same arguments, so the first one stands for all
+	if first != nil {
+		d.Set(Arg_ReplicationEnabled, first.ReplicationEnabled)
+		d.Set(Arg_VolumePool, first.VolumePool)
+		if first.Shareable != nil {
+			d.Set(Arg_VolumeShareable, first.Shareable)
+		}
+		if first.Size != nil {
+			d.Set(Arg_VolumeSize, int(*first.Size))
+		}
+		d.Set(Arg_VolumeType, first.DiskType)
+	}
+
+	return nil
+}
+
+func resourceIBMPIVolumesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+
+	cloudInstanceID, _, err := splitID(d.Id())
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+
+	client := instance.NewIBMPIVolumeClient(ctx, sess, cloudInstanceID)
+	volumeIDs := flex.ExpandStringList(d.Get(Attr_VolumeIDs).([]interface{}))
+
+	if d.HasChanges(Arg_VolumeShareable, Arg_VolumeSize) {
+		shareable := d.Get(Arg_VolumeShareable).(bool)
+		body := &models.UpdateVolume{
+			Shareable: &shareable,
+			Size:      float64(d.Get(Arg_VolumeSize).(int)),
+		}
+		for _, volumeID := range volumeIDs {
+			if _, err := client.UpdateVolume(volumeID, body); err != nil {
+				return piDiagFromErr(err)
+			}
+		}
+		for _, volumeID := range volumeIDs {
+			if _, err := isWaitForIBMPIVolumeAvailable(ctx, client, volumeID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return piDiagFromErr(err)
+			}
+		}
+	}
+
+	if d.HasChange(Arg_VolumeCount) {
+		count := d.Get(Arg_VolumeCount).(int)
+		switch {
+		case count > len(volumeIDs):
+			added, err := createPIVolumes(ctx, d, client, count-len(volumeIDs))
+			volumeIDs = append(volumeIDs, added...)
+			d.Set(Attr_VolumeIDs, volumeIDs)
+			if err != nil {
+				return piDiagFromErr(err)
+			}
+		case count < len(volumeIDs):
+			for len(volumeIDs) > count {
+				last := volumeIDs[len(volumeIDs)-1]
+				if err := deletePIVolume(ctx, client, last, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return piDiagFromErr(err)
+				}
+				volumeIDs = volumeIDs[:len(volumeIDs)-1]
+				d.Set(Attr_VolumeIDs, volumeIDs)
+			}
+		}
+	}
+
+	// Volumes added above are renamed too; existing ones only when the scheme changed
+	if d.HasChanges(Arg_VolumeNameScheme, Arg_VolumeCount) {
+		first := 0
+		if !d.HasChange(Arg_VolumeNameScheme) {
+			o, _ := d.GetChange(Arg_VolumeCount)
+			first = o.(int)
+		}
+		if err := renamePIVolumes(ctx, d, client, volumeIDs, first, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return piDiagFromErr(err)
+		}
+	}
+
+	return resourceIBMPIVolumesRead(ctx, d, meta)
+}
+
+func resourceIBMPIVolumesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+
+	cloudInstanceID, _, err := splitID(d.Id())
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+
+	client := instance.NewIBMPIVolumeClient(ctx, sess, cloudInstanceID)
+	volumeIDs := flex.ExpandStringList(d.Get(Attr_VolumeIDs).([]interface{}))
+	for _, volumeID := range volumeIDs {
+		if err := client.DeleteVolume(volumeID); err != nil {
+			return piDiagFromErr(err)
+		}
+	}
+	for _, volumeID := range volumeIDs {
+		if _, err := isWaitForIBMPIVolumeDeleted(ctx, client, volumeID, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return piDiagFromErr(err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// createPIVolumes creates count volumes in a single multi-volume request and waits for them to be
+// available. The IDs of the created volumes are returned even when waiting fails.
+func createPIVolumes(ctx context.Context, d *schema.ResourceData, client *instance.IBMPIVolumeClient, count int) ([]string, error) {
+	name := d.Get(Arg_VolumeName).(string)
+	size := int64(d.Get(Arg_VolumeSize).(int))
+	shareable := d.Get(Arg_VolumeShareable).(bool)
+	body := &models.MultiVolumesCreate{
+		Count:     int64(count),
+		Name:      &name,
+		Shareable: &shareable,
+		Size:      &size,
+	}
+	if v, ok := d.GetOk(Arg_VolumeType); ok {
+		body.DiskType = v.(string)
+	}
+	if v, ok := d.GetOk(Arg_VolumePool); ok {
+		body.VolumePool = v.(string)
+	}
+	if v, ok := d.GetOk(Arg_ReplicationEnabled); ok {
+		body.ReplicationEnabled = flex.PtrToBool(v.(bool))
+	}
+	if ap, ok := d.GetOk(Arg_AffinityPolicy); ok {
+		policy := ap.(string)
+		body.AffinityPolicy = &policy
+		if policy == "affinity" {
+			if av, ok := d.GetOk(Arg_AffinityVolume); ok {
+				body.AffinityVolume = flex.PtrToString(av.(string))
+			}
+			if ai, ok := d.GetOk(Arg_AffinityInstance); ok {
+				body.AffinityPVMInstance = flex.PtrToString(ai.(string))
+			}
+		} else {
+			if avs, ok := d.GetOk(Arg_AntiAffinityVolumes); ok {
+				body.AntiAffinityVolumes = flex.ExpandStringList(avs.([]interface{}))
+			}
+			if ais, ok := d.GetOk(Arg_AntiAffinityInstances); ok {
+				body.AntiAffinityPVMInstances = flex.ExpandStringList(ais.([]interface{}))
+			}
+		}
+	}
+
+	vols, err := client.CreateVolumeV2(body)
+	if err != nil {
+		return nil, err
+	}
+
+	volumeIDs := make([]string, 0, len(vols.Volumes))
+	for _, vol := range vols.Volumes {
+		if vol != nil && vol.VolumeID != nil {
+			volumeIDs = append(volumeIDs, *vol.VolumeID)
+		}
+	}
+	if len(volumeIDs) != count {
+		return volumeIDs, fmt.Errorf("requested %d volumes named %s but %d were created", count, name, len(volumeIDs))
+	}
+
+	for _, volumeID := range volumeIDs {
+		if _, err := isWaitForIBMPIVolumeAvailable(ctx, client, volumeID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return volumeIDs, err
+		}
+	}
+
+	return volumeIDs, nil
+}
+
+// renamePIVolumes renames the volumes from position first onwards to pi_volume_name_scheme, if set.
+func renamePIVolumes(ctx context.Context, d *schema.ResourceData, client *instance.IBMPIVolumeClient, volumeIDs []string, first int, timeout time.Duration) error {
+	scheme := d.Get(Arg_VolumeNameScheme).(string)
+	if scheme == "" {
+		return nil
+	}
+	for i := first; i < len(volumeIDs); i++ {
+		name := strings.ReplaceAll(scheme, volumeNameSchemeIndex, strconv.Itoa(i+1))
+		if _, err := client.UpdateVolume(volumeIDs[i], &models.UpdateVolume{Name: &name}); err != nil {
+			return fmt.Errorf("failed to rename volume %s to %s: %w", volumeIDs[i], name, err)
+		}
+	}
+	for i := first; i < len(volumeIDs); i++ {
+		if _, err := isWaitForIBMPIVolumeAvailable(ctx, client, volumeIDs[i], timeout); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func deletePIVolume(ctx context.Context, client *instance.IBMPIVolumeClient, volumeID string, timeout time.Duration) error {
+	log.Printf("[DEBUG] deleting volume %s", volumeID)
+	if err := client.DeleteVolume(volumeID); err != nil {
+		return err
+	}
+	_, err := isWaitForIBMPIVolumeDeleted(ctx, client, volumeID, timeout)
+	return err
+}
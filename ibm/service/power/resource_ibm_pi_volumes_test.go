@@ -0,0 +1,81 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMPIVolumesBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-volumes-%d", acctest.RandIntRange(10, 100))
+	volumesRes := "ibm_pi_volumes.power_volumes"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIVolumesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIVolumesConfig(name, 3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(volumesRes, "volume_ids.#", "3"),
+					resource.TestCheckResourceAttr(volumesRes, "volumes.0.name", name+"-data-1"),
+					resource.TestCheckResourceAttr(volumesRes, "volumes.2.name", name+"-data-3"),
+					resource.TestCheckResourceAttrSet(volumesRes, "volumes.0.wwn"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPIVolumesConfig(name, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(volumesRes, "volume_ids.#", "2"),
+					resource.TestCheckResourceAttr(volumesRes, "volumes.1.name", name+"-data-2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIVolumesDestroy(s *terraform.State) error {
+	sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_pi_volumes" {
+			continue
+		}
+		cloudInstanceID := rs.Primary.Attributes["pi_cloud_instance_id"]
+		client := instance.NewIBMPIVolumeClient(context.Background(), sess, cloudInstanceID)
+		for i := 0; rs.Primary.Attributes[fmt.Sprintf("volume_ids.%d", i)] != ""; i++ {
+			volumeID := rs.Primary.Attributes[fmt.Sprintf("volume_ids.%d", i)]
+			if _, err := client.Get(volumeID); err == nil {
+				return fmt.Errorf("PI Volume still exists: %s", volumeID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMPIVolumesConfig(name string, count int) string {
+	return fmt.Sprintf(`
+		resource "ibm_pi_volumes" "power_volumes" {
+			pi_cloud_instance_id  = "%[1]s"
+			pi_volume_count       = %[3]d
+			pi_volume_name        = "%[2]s"
+			pi_volume_name_scheme = "%[2]s-data-{index}"
+			pi_volume_size        = 20
+			pi_volume_type        = "tier3"
+		}`, acc.Pi_cloud_instance_id, name, count)
+}
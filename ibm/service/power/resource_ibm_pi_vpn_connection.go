@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -83,6 +84,7 @@ func ResourceIBMPIVPNConnection() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "Set of CIDR of peer subnets",
 			},
+			Arg_WaitUntil: waitUntilSchema("The status of the VPN connection to wait for after it is created or updated, such as active."),
 
 			//Computed Attributes
 			PIVPNConnectionId: {
@@ -110,6 +112,46 @@ func ResourceIBMPIVPNConnection() *schema.Resource {
 				Computed:    true,
 				Description: "Dead Peer Detection",
 			},
+			Attr_IKEPolicy: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The IKE policy configured for this VPN connection, read from the policy of pi_ike_policy_id",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_Authentication: {Type: schema.TypeString, Computed: true, Description: "Authentication algorithm"},
+						Attr_DHGroup:        {Type: schema.TypeInt, Computed: true, Description: "Diffie-Hellman group number"},
+						Attr_Encryption:     {Type: schema.TypeString, Computed: true, Description: "Encryption algorithm"},
+						Attr_KeyLifetime:    {Type: schema.TypeInt, Computed: true, Description: "Key lifetime in seconds"},
+						Attr_Name:           {Type: schema.TypeString, Computed: true, Description: "Name of the IKE policy"},
+						Attr_Version:        {Type: schema.TypeInt, Computed: true, Description: "IKE protocol version"},
+					},
+				},
+			},
+			Attr_IPSecPolicy: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The IPSec policy configured for this VPN connection, read from the policy of pi_ipsec_policy_id",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_Authentication: {Type: schema.TypeString, Computed: true, Description: "Authentication algorithm"},
+						Attr_DHGroup:        {Type: schema.TypeInt, Computed: true, Description: "Diffie-Hellman group number"},
+						Attr_Encryption:     {Type: schema.TypeString, Computed: true, Description: "Encryption algorithm"},
+						Attr_KeyLifetime:    {Type: schema.TypeInt, Computed: true, Description: "Key lifetime in seconds"},
+						Attr_Name:           {Type: schema.TypeString, Computed: true, Description: "Name of the IPSec policy"},
+						Attr_PFS:            {Type: schema.TypeBool, Computed: true, Description: "Whether perfect forward secrecy is enabled"},
+					},
+				},
+			},
+			Attr_JobID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the last job run for the VPN connection",
+			},
+			Attr_JobStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of the last job run for the VPN connection, for example completed or failed",
+			},
 		},
 	}
 }
@@ -117,7 +159,7 @@ func ResourceIBMPIVPNConnection() *schema.Resource {
 func resourceIBMPIVPNConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Get(helpers.PICloudInstanceId).(string)
@@ -150,11 +192,21 @@ func resourceIBMPIVPNConnectionCreate(ctx context.Context, d *schema.ResourceDat
 		return diag.Errorf("%s is a required field", helpers.PIVPNConnectionPeerSubnets)
 	}
 
+	networkClient := st.NewIBMPINetworkClient(ctx, sess, cloudInstanceID)
+	if err := checkIBMPIVPNConnectionNetworks(networkClient, body.Networks); err != nil {
+		return piDiagFromErr(err)
+	}
+
 	client := st.NewIBMPIVpnConnectionClient(ctx, sess, cloudInstanceID)
-	vpnConnection, err := client.Create(body)
+	var vpnConnection *models.VPNConnectionCreateResponse
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutCreate))
+	err = retryWhileJobInProgress(ctx, deadline, "create VPN connection", func() (err error) {
+		vpnConnection, err = client.Create(body)
+		return
+	})
 	if err != nil {
 		log.Printf("[DEBUG] create VPN connection failed %v", err)
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	vpnConnectionId := *vpnConnection.ID
@@ -164,11 +216,14 @@ func resourceIBMPIVPNConnectionCreate(ctx context.Context, d *schema.ResourceDat
 		jobID := *vpnConnection.JobRef.ID
 		jobClient := st.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
 
-		_, err = waitForIBMPIJobCompleted(ctx, jobClient, jobID, d.Timeout(schema.TimeoutCreate))
+		err = waitForIBMPIJobRecorded(ctx, d, jobClient, jobID, time.Until(deadline))
 		if err != nil {
-			return diag.FromErr(err)
+			return piDiagFromErr(err)
 		}
 	}
+	if err := waitForIBMPIVPNConnectionStatus(ctx, d, client, vpnConnectionId); err != nil {
+		return piDiagFromErr(err)
+	}
 
 	return resourceIBMPIVPNConnectionRead(ctx, d, meta)
 }
@@ -176,18 +231,20 @@ func resourceIBMPIVPNConnectionCreate(ctx context.Context, d *schema.ResourceDat
 func resourceIBMPIVPNConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID, vpnConnectionID, err := splitID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	client := st.NewIBMPIVpnConnectionClient(ctx, sess, cloudInstanceID)
 	jobClient := st.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
+	// The retries and the jobs of all the network changes share the update timeout
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutUpdate))
 
-	if d.HasChangesExcept(helpers.PIVPNConnectionNetworks, helpers.PIVPNConnectionPeerSubnets) {
+	if d.HasChangesExcept(helpers.PIVPNConnectionNetworks, helpers.PIVPNConnectionPeerSubnets, Arg_WaitUntil) {
 		body := &models.VPNConnectionUpdate{}
 
 		if d.HasChanges(helpers.PIVPNConnectionName) {
@@ -209,7 +266,7 @@ func resourceIBMPIVPNConnectionUpdate(ctx context.Context, d *schema.ResourceDat
 
 		_, err = client.Update(vpnConnectionID, body)
 		if err != nil {
-			return diag.FromErr(err)
+			return piDiagFromErr(err)
 		}
 	}
 	if d.HasChanges(helpers.PIVPNConnectionNetworks) {
@@ -220,27 +277,39 @@ func resourceIBMPIVPNConnectionUpdate(ctx context.Context, d *schema.ResourceDat
 		toAdd := new.Difference(old)
 		toRemove := old.Difference(new)
 
+		networkClient := st.NewIBMPINetworkClient(ctx, sess, cloudInstanceID)
+		if err := checkIBMPIVPNConnectionNetworks(networkClient, flex.ExpandStringList(toAdd.List())); err != nil {
+			return piDiagFromErr(err)
+		}
 		for _, n := range flex.ExpandStringList(toAdd.List()) {
-			jobReference, err := client.AddNetwork(vpnConnectionID, n)
+			var jobReference *models.JobReference
+			err := retryWhileJobInProgress(ctx, deadline, "attach network to VPN connection", func() (err error) {
+				jobReference, err = client.AddNetwork(vpnConnectionID, n)
+				return
+			})
 			if err != nil {
-				return diag.FromErr(err)
+				return piDiagFromErr(err)
 			}
 			if jobReference != nil {
-				_, err = waitForIBMPIJobCompleted(ctx, jobClient, *jobReference.ID, d.Timeout(schema.TimeoutUpdate))
+				err = waitForIBMPIJobRecorded(ctx, d, jobClient, *jobReference.ID, time.Until(deadline))
 				if err != nil {
-					return diag.FromErr(err)
+					return piDiagFromErr(err)
 				}
 			}
 		}
 		for _, n := range flex.ExpandStringList(toRemove.List()) {
-			jobReference, err := client.DeleteNetwork(vpnConnectionID, n)
+			var jobReference *models.JobReference
+			err := retryWhileJobInProgress(ctx, deadline, "detach network from VPN connection", func() (err error) {
+				jobReference, err = client.DeleteNetwork(vpnConnectionID, n)
+				return
+			})
 			if err != nil {
-				return diag.FromErr(err)
+				return piDiagFromErr(err)
 			}
 			if jobReference != nil {
-				_, err = waitForIBMPIJobCompleted(ctx, jobClient, *jobReference.ID, d.Timeout(schema.TimeoutUpdate))
+				err = waitForIBMPIJobRecorded(ctx, d, jobClient, *jobReference.ID, time.Until(deadline))
 				if err != nil {
-					return diag.FromErr(err)
+					return piDiagFromErr(err)
 				}
 			}
 		}
@@ -257,28 +326,81 @@ func resourceIBMPIVPNConnectionUpdate(ctx context.Context, d *schema.ResourceDat
 		for _, s := range flex.ExpandStringList(toAdd.List()) {
 			_, err := client.AddSubnet(vpnConnectionID, s)
 			if err != nil {
-				return diag.FromErr(err)
+				return piDiagFromErr(err)
 			}
 		}
 		for _, s := range flex.ExpandStringList(toRemove.List()) {
 			_, err := client.DeleteSubnet(vpnConnectionID, s)
 			if err != nil {
-				return diag.FromErr(err)
+				return piDiagFromErr(err)
 			}
 		}
 	}
+	if err := waitForIBMPIVPNConnectionStatus(ctx, d, client, vpnConnectionID); err != nil {
+		return piDiagFromErr(err)
+	}
 	return resourceIBMPIVPNConnectionRead(ctx, d, meta)
 }
 
+// checkIBMPIVPNConnectionNetworks returns an error naming every network that cannot be attached
+// to a VPN connection, before the connection is created or the networks are added. Only private
+// networks reach the VPN gateway, and the bidirectional access configurations route a network
+// through the satellite location instead.
+func checkIBMPIVPNConnectionNetworks(client *st.IBMPINetworkClient, networkIDs []string) error {
+	var problems []string
+	for _, id := range networkIDs {
+		network, err := client.Get(id)
+		if err != nil {
+			return err
+		}
+		subnet := id
+		if network.Name != nil && network.Cidr != nil {
+			subnet = fmt.Sprintf("%s (%s, %s)", *network.Name, id, *network.Cidr)
+		}
+		switch {
+		case network.Type == nil || *network.Type != models.NetworkTypeVlan:
+			networkType := "unknown"
+			if network.Type != nil {
+				networkType = *network.Type
+			}
+			problems = append(problems, fmt.Sprintf("network %s is of type %s, only private vlan networks can be attached", subnet, networkType))
+		case strings.HasPrefix(string(network.AccessConfig), "bidirectional-"):
+			problems = append(problems, fmt.Sprintf("network %s uses access config %s, use a network with the internal-only or outbound-only access config", subnet, network.AccessConfig))
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("cannot attach the networks to the VPN connection: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// waitForIBMPIVPNConnectionStatus blocks until the VPN connection reaches the status requested
+// with pi_wait_until, for example until the tunnel is active.
+func waitForIBMPIVPNConnectionStatus(ctx context.Context, d *schema.ResourceData, client *st.IBMPIVpnConnectionClient, id string) error {
+	if _, ok := d.GetOk(Arg_WaitUntil); !ok {
+		return nil
+	}
+	return readWithWaitUntil(ctx, d, func() (string, error) {
+		vpnConnection, err := client.Get(id)
+		if err != nil {
+			return "", err
+		}
+		if vpnConnection.Status == nil {
+			return "", nil
+		}
+		return *vpnConnection.Status, nil
+	})
+}
+
 func resourceIBMPIVPNConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID, vpnConnectionID, err := splitID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	client := st.NewIBMPIVpnConnectionClient(ctx, sess, cloudInstanceID)
@@ -292,7 +414,7 @@ func resourceIBMPIVPNConnectionRead(ctx context.Context, d *schema.ResourceData,
 			return nil
 		}
 		log.Printf("[DEBUG] get VPN connection failed %v", err)
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	d.Set(PIVPNConnectionId, vpnConnection.ID)
@@ -322,24 +444,91 @@ func resourceIBMPIVPNConnectionRead(ctx context.Context, d *schema.ResourceData,
 		d.Set(PIVPNConnectionDeadPeerDetection, dpcMap)
 	}
 
+	policyClient := st.NewIBMPIVpnPolicyClient(ctx, sess, cloudInstanceID)
+	if vpnConnection.IkePolicy != nil && vpnConnection.IkePolicy.ID != nil {
+		ikePolicy, err := policyClient.GetIKEPolicy(*vpnConnection.IkePolicy.ID)
+		if err != nil {
+			return piDiagFromErr(err)
+		}
+		d.Set(Attr_IKEPolicy, flattenIBMPIVPNIKEPolicy(ikePolicy))
+	}
+	if vpnConnection.IPSecPolicy != nil && vpnConnection.IPSecPolicy.ID != nil {
+		ipsecPolicy, err := policyClient.GetIPSecPolicy(*vpnConnection.IPSecPolicy.ID)
+		if err != nil {
+			return piDiagFromErr(err)
+		}
+		d.Set(Attr_IPSecPolicy, flattenIBMPIVPNIPSecPolicy(ipsecPolicy))
+	}
+
 	return nil
 }
 
+func flattenIBMPIVPNIKEPolicy(policy *models.IKEPolicy) []map[string]interface{} {
+	m := map[string]interface{}{}
+	if policy.Authentication != nil {
+		m[Attr_Authentication] = string(*policy.Authentication)
+	}
+	if policy.DhGroup != nil {
+		m[Attr_DHGroup] = int(*policy.DhGroup)
+	}
+	if policy.Encryption != nil {
+		m[Attr_Encryption] = *policy.Encryption
+	}
+	if policy.KeyLifetime != nil {
+		m[Attr_KeyLifetime] = int(*policy.KeyLifetime)
+	}
+	if policy.Name != nil {
+		m[Attr_Name] = *policy.Name
+	}
+	if policy.Version != nil {
+		m[Attr_Version] = int(*policy.Version)
+	}
+	return []map[string]interface{}{m}
+}
+
+func flattenIBMPIVPNIPSecPolicy(policy *models.IPSecPolicy) []map[string]interface{} {
+	m := map[string]interface{}{}
+	if policy.Authentication != nil {
+		m[Attr_Authentication] = string(*policy.Authentication)
+	}
+	if policy.DhGroup != nil {
+		m[Attr_DHGroup] = int(*policy.DhGroup)
+	}
+	if policy.Encryption != nil {
+		m[Attr_Encryption] = *policy.Encryption
+	}
+	if policy.KeyLifetime != nil {
+		m[Attr_KeyLifetime] = int(*policy.KeyLifetime)
+	}
+	if policy.Name != nil {
+		m[Attr_Name] = *policy.Name
+	}
+	if policy.Pfs != nil {
+		m[Attr_PFS] = *policy.Pfs
+	}
+	return []map[string]interface{}{m}
+}
+
 func resourceIBMPIVPNConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID, vpnConnectionID, err := splitID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	client := st.NewIBMPIVpnConnectionClient(ctx, sess, cloudInstanceID)
 	jobClient := st.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
 
-	jobRef, err := client.Delete(vpnConnectionID)
+	var jobRef *models.JobReference
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutDelete))
+	err = retryWhileJobInProgress(ctx, deadline, "delete VPN connection", func() (err error) {
+		jobRef, err = client.Delete(vpnConnectionID)
+		return
+	})
 	if err != nil {
 		uErr := errors.Unwrap(err)
 		switch uErr.(type) {
@@ -349,13 +538,13 @@ func resourceIBMPIVPNConnectionDelete(ctx context.Context, d *schema.ResourceDat
 			return nil
 		}
 		log.Printf("[DEBUG] delete VPN connection failed %v", err)
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	if jobRef != nil {
 		jobID := *jobRef.ID
-		_, err = waitForIBMPIJobCompleted(ctx, jobClient, jobID, d.Timeout(schema.TimeoutCreate))
+		_, err = waitForIBMPIJobCompleted(ctx, jobClient, jobID, time.Until(deadline))
 		if err != nil {
-			return diag.FromErr(err)
+			return piDiagFromErr(err)
 		}
 	}
 
@@ -34,6 +34,8 @@ func TestAccIBMPIVPNConnectionBasic(t *testing.T) {
 					resource.TestCheckResourceAttr(connectionRes, "pi_vpn_connection_name", name),
 					resource.TestCheckResourceAttrSet(connectionRes, "connection_id"),
 					resource.TestCheckResourceAttrSet(connectionRes, "connection_status"),
+					resource.TestCheckResourceAttr(connectionRes, "ike_policy.#", "1"),
+					resource.TestCheckResourceAttr(connectionRes, "ipsec_policy.#", "1"),
 					resource.TestCheckResourceAttr(connectionRes, "pi_networks.#", "1"),
 					resource.TestCheckResourceAttr(connectionRes, "pi_peer_subnets.#", "1"),
 				),
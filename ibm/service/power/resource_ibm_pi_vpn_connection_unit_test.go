@@ -0,0 +1,86 @@
+// Copyright IBM Corp. 2024 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/power/internal/powertest"
+)
+
+func TestFlattenIBMPIVPNPolicies(t *testing.T) {
+	name, encryption := "ike-1", "aes-256-cbc"
+	dhGroup, version := int64(14), int64(2)
+	lifetime := models.KeyLifetime(28800)
+	authentication := models.IKEPolicyAuthentication("sha-256")
+
+	ike := flattenIBMPIVPNIKEPolicy(&models.IKEPolicy{
+		Authentication: &authentication,
+		DhGroup:        &dhGroup,
+		Encryption:     &encryption,
+		KeyLifetime:    &lifetime,
+		Name:           &name,
+		Version:        &version,
+	})
+	if len(ike) != 1 || ike[0][Attr_Authentication] != "sha-256" || ike[0][Attr_DHGroup] != 14 || ike[0][Attr_KeyLifetime] != 28800 || ike[0][Attr_Version] != 2 {
+		t.Errorf("ike_policy = %v", ike)
+	}
+
+	pfs := true
+	ipsec := flattenIBMPIVPNIPSecPolicy(&models.IPSecPolicy{Name: &name, Pfs: &pfs})
+	if len(ipsec) != 1 || ipsec[0][Attr_PFS] != true || ipsec[0][Attr_Name] != name {
+		t.Errorf("ipsec_policy = %v", ipsec)
+	}
+	if _, ok := ipsec[0][Attr_DHGroup]; ok {
+		t.Error("dh_group was set for a policy without one")
+	}
+}
+
+func TestCheckIBMPIVPNConnectionNetworks(t *testing.T) {
+	server := powertest.NewServer(t)
+	client := instance.NewIBMPINetworkClient(context.Background(), server.Session(t), powertest.CloudInstanceID)
+
+	private, public, satellite := testPINetwork("private", "10.0.0.0/24", 100), testPINetwork("public", "192.168.0.0/24", 200), testPINetwork("satellite", "10.1.0.0/24", 300)
+	vlan, pubVlan := models.NetworkTypeVlan, models.NetworkTypePubDashVlan
+	private.Type, public.Type, satellite.Type = &vlan, &pubVlan, &vlan
+	satellite.AccessConfig = models.AccessConfigBidirectionalDashBgp
+	for _, network := range []*models.Network{private, public, satellite} {
+		server.AddNetwork(network)
+	}
+
+	tests := []struct {
+		name     string
+		networks []string
+		wantErr  []string
+	}{
+		{name: "private", networks: []string{"private"}},
+		{name: "public", networks: []string{"private", "public"}, wantErr: []string{"net-public (public, 192.168.0.0/24) is of type pub-vlan"}},
+		{name: "access config", networks: []string{"satellite"}, wantErr: []string{"net-satellite (satellite, 10.1.0.0/24) uses access config bidirectional-bgp"}},
+		{name: "all offending networks", networks: []string{"public", "satellite"}, wantErr: []string{"net-public", "net-satellite"}},
+		{name: "missing", networks: []string{"missing"}, wantErr: []string{"not found"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkIBMPIVPNConnectionNetworks(client, tc.networks)
+			if len(tc.wantErr) == 0 {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			for _, want := range tc.wantErr {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("error = %v, want it to contain %q", err, want)
+				}
+			}
+		})
+	}
+}
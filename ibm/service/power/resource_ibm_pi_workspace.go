@@ -7,9 +7,11 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -20,11 +22,13 @@ func ResourceIBMPIWorkspace() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceIBMPIWorkspaceCreate,
 		ReadContext:   resourceIBMPIWorkspaceRead,
+		UpdateContext: resourceIBMPIWorkspaceUpdate,
 		DeleteContext: resourceIBMPIWorkspaceDelete,
 		Importer:      &schema.ResourceImporter{},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
@@ -39,7 +43,6 @@ func ResourceIBMPIWorkspace() *schema.Resource {
 			},
 			Arg_Name: {
 				Description:  "A descriptive name used to identify the workspace.",
-				ForceNew:     true,
 				Required:     true,
 				Type:         schema.TypeString,
 				ValidateFunc: validation.NoZeroValues,
@@ -52,6 +55,12 @@ func ResourceIBMPIWorkspace() *schema.Resource {
 				Type:         schema.TypeString,
 				ValidateFunc: validate.ValidateAllowedStringValues([]string{Private, Public}),
 			},
+			Arg_RequirePowerEdgeRouter: {
+				Default:     false,
+				Description: "Require the workspace to use a Power Edge Router; creation fails if the datacenter does not support it and waits until the router is active. Only checked when the workspace is created.",
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
 			Arg_ResourceGroupID: {
 				Description:  "The ID of the resource group where you want to create the workspace. You can retrieve the value from data source ibm_resource_group.",
 				ForceNew:     true,
@@ -61,38 +70,98 @@ func ResourceIBMPIWorkspace() *schema.Resource {
 			},
 
 			// Attributes
+			Attr_AccountID: {
+				Computed:    true,
+				Description: "The ID of the account that owns the workspace.",
+				Type:        schema.TypeString,
+			},
+			Attr_CreatedBy: {
+				Computed:    true,
+				Description: "The ID of the user or service ID that created the workspace.",
+				Type:        schema.TypeString,
+			},
+			Attr_PowerEdgeRouterEnabled: {
+				Computed:    true,
+				Description: "Indicates if the workspace uses an active Power Edge Router.",
+				Type:        schema.TypeBool,
+			},
+			Attr_WorkspaceCapabilities: {
+				Computed:    true,
+				Description: "The capabilities of the workspace, such as power-edge-router.",
+				Elem:        &schema.Schema{Type: schema.TypeBool},
+				Type:        schema.TypeMap,
+			},
 			Attr_WorkspaceDetails: {
 				Computed:    true,
 				Description: "Workspace information.",
 				Type:        schema.TypeMap,
 			},
+			Attr_WorkspaceLocation: {
+				Computed:    true,
+				Description: "The region, type and URL of the workspace location.",
+				Type:        schema.TypeMap,
+			},
+			Attr_WorkspaceStatus: {
+				Computed:    true,
+				Description: "The status of the workspace, such as active, critical, failed or provisioning.",
+				Type:        schema.TypeString,
+			},
+			Attr_WorkspaceType: {
+				Computed:    true,
+				Description: "The type of the workspace, off-premises or on-premises.",
+				Type:        schema.TypeString,
+			},
 		},
 	}
 }
 
+// piWorkspacePlans maps the resource plan IDs of workspaces to their pi_plan.
+var piWorkspacePlans = map[string]string{
+	"f165dd34-3a40-423b-9d95-e90a23f724dd": Public,
+	"1112d6a9-71d6-4968-956b-eb3edbf0225b": Private,
+}
+
 func resourceIBMPIWorkspaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	name := d.Get(Arg_Name).(string)
 	datacenter := d.Get(Arg_Datacenter).(string)
 	resourceGroup := d.Get(Arg_ResourceGroupID).(string)
 	plan := d.Get(Arg_Plan).(string)
+	requirePER := d.Get(Arg_RequirePowerEdgeRouter).(bool)
+
+	if requirePER {
+		dcClient := instance.NewIBMPIDatacenterClient(ctx, sess, "")
+		dc, err := dcClient.Get(datacenter)
+		if err != nil {
+			return piDiagFromErr(err)
+		}
+		if !dc.Capabilities[Capability_PowerEdgeRouter] {
+			return diag.Errorf("datacenter %s does not support Power Edge Router, required by %s", datacenter, Arg_RequirePowerEdgeRouter)
+		}
+	}
 
 	// No need for cloudInstanceID because we are creating a workspace
 	client := instance.NewIBMPIWorkspacesClient(ctx, sess, "")
 	controller, _, err := client.Create(name, datacenter, resourceGroup, plan)
 	if err != nil {
 		log.Printf("[DEBUG] create workspace failed %v", err)
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	d.SetId(*controller.GUID)
 	_, err = waitForResourceInstanceCreate(ctx, client, *controller.GUID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
+	}
+	if requirePER {
+		_, err = waitForPowerEdgeRouterActive(ctx, client, *controller.GUID, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return piDiagFromErr(err)
+		}
 	}
 
 	return resourceIBMPIWorkspaceRead(ctx, d, meta)
@@ -123,34 +192,122 @@ func isIBMPIWorkspaceCreateRefreshFunc(client *instance.IBMPIWorkspacesClient, i
 	}
 }
 
+func waitForPowerEdgeRouterActive(ctx context.Context, client *instance.IBMPIWorkspacesClient, id string, timeout time.Duration) (interface{}, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{State_Pending},
+		Target:     []string{State_Active},
+		Refresh:    isIBMPIPowerEdgeRouterRefreshFunc(client, id),
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+		Timeout:    timeout,
+	}
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isIBMPIPowerEdgeRouterRefreshFunc(client *instance.IBMPIWorkspacesClient, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		ws, err := client.Get(id)
+		if err != nil {
+			return nil, "", err
+		}
+		if ws.Details == nil || ws.Details.PowerEdgeRouter == nil || ws.Details.PowerEdgeRouter.State == nil {
+			return ws, State_Pending, nil
+		}
+		switch state := *ws.Details.PowerEdgeRouter.State; state {
+		case State_Active:
+			return ws, State_Active, nil
+		case State_Error, State_Failed:
+			return ws, state, fmt.Errorf("[ERROR] the Power Edge Router of workspace %s is in state %s", id, state)
+		}
+		return ws, State_Pending, nil
+	}
+}
+
 func resourceIBMPIWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// session
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Id()
 	client := instance.NewIBMPIWorkspacesClient(ctx, sess, cloudInstanceID)
 	controller, _, err := client.GetRC(cloudInstanceID)
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
+	d.Set(Arg_Datacenter, controller.RegionID)
 	d.Set(Arg_Name, controller.Name)
+	if controller.ResourcePlanID != nil {
+		if plan, ok := piWorkspacePlans[*controller.ResourcePlanID]; ok {
+			d.Set(Arg_Plan, plan)
+		}
+	}
+	d.Set(Arg_ResourceGroupID, controller.ResourceGroupID)
+	d.Set(Attr_AccountID, controller.AccountID)
+	d.Set(Attr_CreatedBy, controller.CreatedBy)
+
+	ws, err := client.Get(cloudInstanceID)
+	if err != nil {
+		return piDiagFromErr(err)
+	}
+	d.Set(Attr_PowerEdgeRouterEnabled, isPowerEdgeRouterActive(ws))
+	d.Set(Attr_WorkspaceCapabilities, ws.Capabilities)
+	d.Set(Attr_WorkspaceStatus, ws.Status)
+	d.Set(Attr_WorkspaceType, ws.Type)
+
 	wsDetails := map[string]interface{}{
 		Attr_CreationDate: controller.CreatedAt,
-		Attr_CRN:          controller.TargetCRN,
+		Attr_CRN:          controller.CRN,
+	}
+	if ws.Details != nil {
+		if ws.Details.CreationDate != nil {
+			wsDetails[Attr_CreationDate] = ws.Details.CreationDate.String()
+		}
+		if ws.Details.Crn != nil {
+			wsDetails[Attr_CRN] = *ws.Details.Crn
+		}
 	}
-
 	d.Set(Attr_WorkspaceDetails, flex.Flatten(wsDetails))
 
+	if ws.Location != nil {
+		wsLocation := map[string]interface{}{
+			Attr_Region: ws.Location.Region,
+			Attr_Type:   ws.Location.Type,
+			Attr_URL:    ws.Location.URL,
+		}
+		d.Set(Attr_WorkspaceLocation, flex.Flatten(wsLocation))
+	}
+
 	return nil
 }
 
+func resourceIBMPIWorkspaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange(Arg_Name) {
+		// The Power API cannot rename a workspace, but the resource controller can rename the
+		// resource instance that backs it.
+		rcClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+		if err != nil {
+			return piDiagFromErr(err)
+		}
+		id := d.Id()
+		name := d.Get(Arg_Name).(string)
+		_, _, err = rcClient.UpdateResourceInstanceWithContext(ctx, &rc.UpdateResourceInstanceOptions{
+			ID:   &id,
+			Name: &name,
+		})
+		if err != nil {
+			return piDiagFromErr(fmt.Errorf("failed to rename workspace %s: %w", id, err))
+		}
+	}
+
+	return resourceIBMPIWorkspaceRead(ctx, d, meta)
+}
+
 func resourceIBMPIWorkspaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	sess, err := meta.(conns.ClientSession).IBMPISession()
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 
 	cloudInstanceID := d.Id()
@@ -161,7 +318,7 @@ func resourceIBMPIWorkspaceDelete(ctx context.Context, d *schema.ResourceData, m
 	}
 	_, err = waitForResourceInstanceDelete(ctx, client, cloudInstanceID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
-		return diag.FromErr(err)
+		return piDiagFromErr(err)
 	}
 	d.SetId("")
 
@@ -199,3 +356,8 @@ func isIBMPIResourceDeleteRefreshFunc(client *instance.IBMPIWorkspacesClient, id
 		}
 	}
 }
+
+func isPowerEdgeRouterActive(ws *models.Workspace) bool {
+	return ws.Details != nil && ws.Details.PowerEdgeRouter != nil && ws.Details.PowerEdgeRouter.State != nil &&
+		*ws.Details.PowerEdgeRouter.State == State_Active
+}
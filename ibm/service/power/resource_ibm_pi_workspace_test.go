@@ -28,6 +28,18 @@ func TestAccIBMPIWorkspaceBasic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckIBMPIWorkspaceExists("ibm_pi_workspace.powervs_service_instance"),
 					resource.TestCheckResourceAttrSet("ibm_pi_workspace.powervs_service_instance", "id"),
+					resource.TestCheckResourceAttrSet("ibm_pi_workspace.powervs_service_instance", "account_id"),
+					resource.TestCheckResourceAttr("ibm_pi_workspace.powervs_service_instance", "pi_resource_group_id", acc.Pi_resource_group_id),
+					resource.TestCheckResourceAttrSet("ibm_pi_workspace.powervs_service_instance", "pi_workspace_details.crn"),
+					resource.TestCheckResourceAttrSet("ibm_pi_workspace.powervs_service_instance", "pi_workspace_location.url"),
+					resource.TestCheckResourceAttr("ibm_pi_workspace.powervs_service_instance", "pi_workspace_status", "active"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPIWorkspaceConfig(name + "-renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIWorkspaceExists("ibm_pi_workspace.powervs_service_instance"),
+					resource.TestCheckResourceAttr("ibm_pi_workspace.powervs_service_instance", "pi_name", name+"-renamed"),
 				),
 			},
 		},
@@ -79,6 +79,17 @@ func getClientWithInstanceEndpoint(originalClient *secretsmanagerv2.SecretsManag
 	return newClient
 }
 
+// GetClientWithInstanceEndpoint clones the base secrets manager client with the API endpoint of the
+// instance, for resources of other services that read secrets. The endpoint type follows the base
+// client of the provider.
+func GetClientWithInstanceEndpoint(originalClient *secretsmanagerv2.SecretsManagerV2, instanceId string, region string) *secretsmanagerv2.SecretsManagerV2 {
+	endpointType := "public"
+	if strings.Contains(originalClient.Service.GetServiceURL(), "private.") {
+		endpointType = "private"
+	}
+	return getClientWithInstanceEndpoint(originalClient, instanceId, region, endpointType)
+}
+
 // Add the fields needed for building the instance endpoint to the given schema
 func AddInstanceFields(resource *schema.Resource) *schema.Resource {
 	resource.Schema["instance_id"] = &schema.Schema{
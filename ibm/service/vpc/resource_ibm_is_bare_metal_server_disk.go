@@ -6,8 +6,10 @@ package vpc
 import (
 	"context"
 	"fmt"
+	"log"
 	"time"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -20,7 +22,24 @@ func ResourceIBMIsBareMetalServerDisk() *schema.Resource {
 		ReadContext:   resourceIBMISBareMetalServerDiskRead,
 		UpdateContext: resourceIBMISBareMetalServerDiskUpdate,
 		DeleteContext: resourceIBMISBareMetalServerDiskDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) (result []*schema.ResourceData, err error) {
+				// Accept <bare_metal_server>/<disk> so that the server identifier is known on import
+				parts, err := flex.IdParts(d.Id())
+				if err == nil && (len(parts) != 2 || parts[0] == "" || parts[1] == "") {
+					err = fmt.Errorf("invalid ID %s", d.Id())
+				}
+				if err != nil {
+					return nil, fmt.Errorf("[ERROR] Error importing bare metal server disk, expected ID in the format <bare_metal_server>/<disk>: %s", err)
+				}
+				bareMetalServerId, diskId := parts[0], parts[1]
+				log.Printf("[INFO] Bare metal server (%s) disk (%s) importing", bareMetalServerId, diskId)
+				d.Set(isBareMetalServerID, bareMetalServerId)
+				d.Set(isBareMetalServerDisk, diskId)
+				d.SetId(diskId)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -48,6 +67,26 @@ func ResourceIBMIsBareMetalServerDisk() *schema.Resource {
 				Description:  "Bare metal server disk name",
 				ValidateFunc: validate.InvokeValidator("ibm_is_bare_metal_server_disk", isBareMetalServerDiskName),
 			},
+			isBareMetalServerDiskHref: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL for this bare metal server disk",
+			},
+			isBareMetalServerDiskInterfaceType: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The disk interface used for attaching the disk. Supported values are [ nvme, sata ]",
+			},
+			isBareMetalServerDiskResourceType: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The resource type",
+			},
+			isBareMetalServerDiskSize: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The size of the disk in GB (gigabytes)",
+			},
 		},
 	}
 }
@@ -128,6 +167,18 @@ func bareMetalServerDiskGet(context context.Context, d *schema.ResourceData, ses
 	d.Set(isBareMetalServerID, bareMetalServerId)
 	d.Set(isBareMetalServerDisk, *disk.ID)
 	d.Set(isBareMetalServerDiskName, *disk.Name)
+	if disk.Href != nil {
+		d.Set(isBareMetalServerDiskHref, *disk.Href)
+	}
+	if disk.InterfaceType != nil {
+		d.Set(isBareMetalServerDiskInterfaceType, *disk.InterfaceType)
+	}
+	if disk.ResourceType != nil {
+		d.Set(isBareMetalServerDiskResourceType, *disk.ResourceType)
+	}
+	if disk.Size != nil {
+		d.Set(isBareMetalServerDiskSize, *disk.Size)
+	}
 
 	return nil
 }
@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccIBMISBareMetalServerDisk_basic(t *testing.T) {
@@ -39,6 +40,10 @@ ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVE
 						"ibm_is_bare_metal_server.testacc_bms", "zone", acc.ISZoneName),
 					resource.TestCheckResourceAttr(
 						"ibm_is_bare_metal_server_disk.testacc_bms_disk", "name", diskName1),
+					resource.TestCheckResourceAttrSet(
+						"ibm_is_bare_metal_server_disk.testacc_bms_disk", "interface_type"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_is_bare_metal_server_disk.testacc_bms_disk", "size"),
 				),
 			},
 			{
@@ -53,10 +58,26 @@ ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCKVmnMOlHKcZK8tpt3MP1lqOLAcqcJzhsvJcjscgVE
 						"ibm_is_bare_metal_server_disk.testacc_bms_disk", "name", diskName2),
 				),
 			},
+			{
+				ResourceName:      "ibm_is_bare_metal_server_disk.testacc_bms_disk",
+				ImportState:       true,
+				ImportStateIdFunc: testAccIBMISBareMetalServerDiskImportStateIdFunc("ibm_is_bare_metal_server_disk.testacc_bms_disk"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
+func testAccIBMISBareMetalServerDiskImportStateIdFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["bare_metal_server"], rs.Primary.ID), nil
+	}
+}
+
 func testAccCheckIBMISBareMetalServerDiskConfig(vpcname, subnetname, sshname, publicKey, name, diskName string) string {
 	return fmt.Sprintf(`
 		resource "ibm_is_vpc" "testacc_vpc" {